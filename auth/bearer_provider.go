@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/splicemachine/splicectl/common"
+	"github.com/splicemachine/splicectl/kube"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TokenSecret - the secret holding the token bearer of each session
+const TokenSecret = "splicectl-api-tokens"
+
+// EnvExecInfo - the environment variable the exec plugin receives the
+// session in, as JSON
+const EnvExecInfo = "SPLICECTL_EXEC_INFO"
+
+// defaultExecTimeout - how long the exec plugin may run when Timeout isn't set
+const defaultExecTimeout = time.Minute
+
+// BearerProvider - retrieves the token bearer of a session, an empty bearer
+// means the session isn't known.
+type BearerProvider interface {
+	Bearer(session common.SessionData) (string, error)
+}
+
+// SecretProvider - reads the token bearer from the splicectl-api-tokens
+// secret, which needs RBAC read access to the secret.
+type SecretProvider struct {
+	Kube *kube.Factory
+}
+
+// Bearer - the <session_id>_token-bearer key of the secret
+func (p *SecretProvider) Bearer(session common.SessionData) (string, error) {
+	client, err := p.Kube.Clientset()
+	if err != nil {
+		return "", fmt.Errorf("could not create client from config: %v", err)
+	}
+	secretResult, err := client.CoreV1().Secrets(p.Kube.Namespace()).Get(context.TODO(), TokenSecret, v1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("could not read from secret: %s: %v", TokenSecret, err)
+	}
+	bearerPath := fmt.Sprintf("%s_token-bearer", session.SessionID)
+	return strings.TrimSpace(string(secretResult.Data[bearerPath])), nil
+}
+
+// ExecEnvVar - an environment variable set for the exec plugin
+type ExecEnvVar struct {
+	Name  string `json:"name" yaml:"name" mapstructure:"name"`
+	Value string `json:"value" yaml:"value" mapstructure:"value"`
+}
+
+// ExecConfig - the external command retrieving the token bearer, in the
+// style of the kubectl exec credential plugin
+type ExecConfig struct {
+	Command string        `json:"command" yaml:"command" mapstructure:"command"`
+	Args    []string      `json:"args,omitempty" yaml:"args,omitempty" mapstructure:"args"`
+	Env     []ExecEnvVar  `json:"env,omitempty" yaml:"env,omitempty" mapstructure:"env"`
+	Timeout time.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty" mapstructure:"timeout"`
+}
+
+// ExecInfo - the session passed to the exec plugin in SPLICECTL_EXEC_INFO
+type ExecInfo struct {
+	Environment string `json:"environment"`
+	SessionID   string `json:"session_id"`
+	ValidUntil  string `json:"valid_until"`
+}
+
+// ExecCredential - what the exec plugin prints on stdout, Expiry is an
+// RFC3339 time and may be left out.
+type ExecCredential struct {
+	Bearer string `json:"bearer"`
+	Expiry string `json:"expiry,omitempty"`
+}
+
+// ExecProvider - runs the configured command and reads an ExecCredential
+// from its stdout.  Its stdin and stderr are the terminal's so it can
+// prompt.
+type ExecProvider struct {
+	Config      ExecConfig
+	Environment string
+}
+
+// Bearer - the bearer printed by the command, an expired credential is an
+// error
+func (p *ExecProvider) Bearer(session common.SessionData) (string, error) {
+	if len(p.Config.Command) == 0 {
+		return "", fmt.Errorf("the bearer exec plugin has no command")
+	}
+	info, err := json.Marshal(ExecInfo{
+		Environment: p.Environment,
+		SessionID:   session.SessionID,
+		ValidUntil:  session.ValidUntil,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	timeout := p.Config.Timeout
+	if timeout <= 0 {
+		timeout = defaultExecTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, p.Config.Command, p.Config.Args...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", EnvExecInfo, info))
+	for _, env := range p.Config.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", env.Name, env.Value))
+	}
+	var stdout bytes.Buffer
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("bearer exec plugin %s failed: %v", p.Config.Command, err)
+	}
+
+	var credential ExecCredential
+	if err := json.Unmarshal(stdout.Bytes(), &credential); err != nil {
+		return "", fmt.Errorf("bearer exec plugin %s printed invalid JSON: %v", p.Config.Command, err)
+	}
+	if len(credential.Expiry) > 0 {
+		expiry, err := time.Parse(time.RFC3339, credential.Expiry)
+		if err != nil {
+			return "", fmt.Errorf("bearer exec plugin %s printed an invalid expiry: %v", p.Config.Command, err)
+		}
+		if expiry.Before(time.Now()) {
+			return "", fmt.Errorf("bearer exec plugin %s printed a credential that expired at %s", p.Config.Command, credential.Expiry)
+		}
+	}
+	return strings.TrimSpace(credential.Bearer), nil
+}
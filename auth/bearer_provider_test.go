@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/splicemachine/splicectl/common"
+	"github.com/splicemachine/splicectl/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSecretProvider(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: TokenSecret, Namespace: "splice-test"},
+		Data:       map[string][]byte{"abc123_token-bearer": []byte("token\n")},
+	})
+	provider := &SecretProvider{Kube: kube.NewFactoryForClientset(clientset, nil, "splice-test")}
+
+	bearer, err := provider.Bearer(common.SessionData{SessionID: "abc123"})
+	if err != nil || bearer != "token" {
+		t.Fatalf("expected bearer token, got: %q %v", bearer, err)
+	}
+	if bearer, err := provider.Bearer(common.SessionData{SessionID: "other"}); err != nil || bearer != "" {
+		t.Fatalf("expected no bearer for an unknown session, got: %q %v", bearer, err)
+	}
+
+	provider = &SecretProvider{Kube: kube.NewFactoryForClientset(clientset, nil, "splice-system")}
+	if _, err := provider.Bearer(common.SessionData{SessionID: "abc123"}); err == nil {
+		t.Fatalf("expected an error when the secret is missing")
+	}
+}
+
+func TestExecProvider(t *testing.T) {
+	session := common.SessionData{SessionID: "abc123", ValidUntil: "2030-01-02T03:04:05Z"}
+	expiry := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+	tests := []struct {
+		name    string
+		config  ExecConfig
+		bearer  string
+		wantErr string
+	}{
+		{
+			name:   "bearer and expiry",
+			config: ExecConfig{Command: "sh", Args: []string{"-c", `echo '{"bearer": " token ", "expiry": "` + expiry + `"}'`}},
+			bearer: "token",
+		},
+		{
+			name: "session and env passed to the command",
+			config: ExecConfig{
+				Command: "sh",
+				Args:    []string{"-c", `echo "{\"bearer\": \"$PREFIX-$(echo $SPLICECTL_EXEC_INFO | sed 's/.*session_id":"\([^"]*\).*/\1/')\"}"`},
+				Env:     []ExecEnvVar{{Name: "PREFIX", Value: "dev"}},
+			},
+			bearer: "dev-abc123",
+		},
+		{
+			name:   "unknown session",
+			config: ExecConfig{Command: "sh", Args: []string{"-c", `echo '{"bearer": ""}'`}},
+			bearer: "",
+		},
+		{
+			name:    "expired",
+			config:  ExecConfig{Command: "sh", Args: []string{"-c", `echo '{"bearer": "token", "expiry": "2001-01-01T00:00:00Z"}'`}},
+			wantErr: "expired",
+		},
+		{
+			name:    "invalid JSON",
+			config:  ExecConfig{Command: "sh", Args: []string{"-c", "echo token"}},
+			wantErr: "invalid JSON",
+		},
+		{
+			name:    "command fails",
+			config:  ExecConfig{Command: "sh", Args: []string{"-c", "exit 3"}},
+			wantErr: "failed",
+		},
+		{
+			name:    "timeout",
+			config:  ExecConfig{Command: "sleep", Args: []string{"5"}, Timeout: 100 * time.Millisecond},
+			wantErr: "failed",
+		},
+		{
+			name:    "no command",
+			wantErr: "no command",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &ExecProvider{Config: tt.config, Environment: "dev"}
+			bearer, err := provider.Bearer(session)
+			if len(tt.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected an error containing %q, got: %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			if bearer != tt.bearer {
+				t.Fatalf("expected bearer %q, got: %q", tt.bearer, bearer)
+			}
+		})
+	}
+}
+
+type failingProvider struct{}
+
+func (failingProvider) Bearer(session common.SessionData) (string, error) {
+	return "", errors.New("forbidden")
+}
+
+func TestCheckTokenValidity(t *testing.T) {
+	session := common.SessionData{SessionID: "abc123", ValidUntil: time.Now().Add(time.Hour).UTC().Format(time.RFC3339)}
+
+	if valid, err := NewAuth("dev", failingProvider{}, session).CheckTokenValidity(); valid || err == nil {
+		t.Fatalf("expected the provider error, got: %t %v", valid, err)
+	}
+
+	expired := common.SessionData{SessionID: "abc123", ValidUntil: "2001-01-01T00:00:00Z"}
+	if valid, err := NewAuth("dev", failingProvider{}, expired).CheckTokenValidity(); valid || err != nil {
+		t.Fatalf("expected an expired session to be invalid without asking the provider, got: %t %v", valid, err)
+	}
+}
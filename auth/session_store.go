@@ -0,0 +1,249 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/splicemachine/splicectl/common"
+	"golang.org/x/crypto/scrypt"
+	"sigs.k8s.io/yaml"
+)
+
+// The session store backends, selected by the session-store config key
+const (
+	StoreFile          = "file"
+	StoreEncryptedFile = "encrypted-file"
+	StoreEnv           = "env"
+)
+
+// The environment variables read by the env session store
+const (
+	EnvSessionID         = "SPLICECTL_SESSION_ID"
+	EnvSessionValidUntil = "SPLICECTL_SESSION_VALID_UNTIL"
+)
+
+// EnvSessionKey - the passphrase of the encrypted-file session store, it
+// can't be used without one
+const EnvSessionKey = "SPLICECTL_SESSION_KEY"
+
+// The header of the encrypted sessions file, the version of its format
+// followed by the salt the key is derived with
+const (
+	sessionFileVersion = 1
+	sessionSaltSize    = 16
+)
+
+// The scrypt cost of deriving the key of the encrypted sessions file
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// ErrReadOnlyStore - the session store can't save sessions
+var ErrReadOnlyStore = errors.New("the session store is read only")
+
+// SessionStore - keeps the session of each environment or context between
+// commands.  Load returns an empty session when none is stored for key.
+type SessionStore interface {
+	Load(key string) (common.SessionData, error)
+	Save(key string, session common.SessionData) error
+}
+
+// NewSessionStore - the session store backend called name, dir holds the
+// files of the file backends, ie: ~/.splicectl
+func NewSessionStore(name string, dir string) (SessionStore, error) {
+	switch name {
+	case StoreFile:
+		return &FileStore{Path: filepath.Join(dir, "sessions.yml")}, nil
+	case StoreEncryptedFile:
+		return &EncryptedFileStore{
+			Path:       filepath.Join(dir, "sessions.enc"),
+			Passphrase: os.Getenv(EnvSessionKey),
+		}, nil
+	case StoreEnv:
+		return &EnvStore{}, nil
+	}
+	return nil, fmt.Errorf("unknown session store %q, valid options are %s, %s and %s", name, StoreFile, StoreEncryptedFile, StoreEnv)
+}
+
+// FileStore - sessions kept in a YAML file readable only by the user
+type FileStore struct {
+	Path string
+}
+
+// Load - the session stored for key
+func (s *FileStore) Load(key string) (common.SessionData, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return common.SessionData{}, nil
+		}
+		return common.SessionData{}, err
+	}
+	return loadSession(data, key)
+}
+
+// Save - store the session for key, an empty session removes it
+func (s *FileStore) Save(key string, session common.SessionData) error {
+	var data []byte
+	if existing, err := ioutil.ReadFile(s.Path); err == nil {
+		data = existing
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	out, err := saveSession(data, key, session)
+	if err != nil {
+		return err
+	}
+	return writePrivate(s.Path, out)
+}
+
+// EncryptedFileStore - sessions kept in an AES-GCM encrypted file, the key
+// is derived from Passphrase with scrypt and a random salt kept in the file.
+// The passphrase is never written to disk, so it must be given, ie: in
+// SPLICECTL_SESSION_KEY.
+type EncryptedFileStore struct {
+	Path       string
+	Passphrase string
+}
+
+// Load - the session stored for key
+func (s *EncryptedFileStore) Load(key string) (common.SessionData, error) {
+	data, err := s.read()
+	if err != nil {
+		return common.SessionData{}, err
+	}
+	return loadSession(data, key)
+}
+
+// Save - store the session for key, an empty session removes it
+func (s *EncryptedFileStore) Save(key string, session common.SessionData) error {
+	data, err := s.read()
+	if err != nil {
+		return err
+	}
+	out, err := saveSession(data, key, session)
+	if err != nil {
+		return err
+	}
+
+	salt := make([]byte, sessionSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return err
+	}
+	gcm, err := s.cipher(salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	// the header is authenticated with the sessions
+	header := append([]byte{sessionFileVersion}, salt...)
+	return writePrivate(s.Path, gcm.Seal(append(header, nonce...), nonce, out, header))
+}
+
+// read - the decrypted sessions file, nil when there isn't one
+func (s *EncryptedFileStore) read() ([]byte, error) {
+	sealed, err := ioutil.ReadFile(s.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(sealed) < 1+sessionSaltSize {
+		return nil, fmt.Errorf("%s is not a session file", s.Path)
+	}
+	if sealed[0] != sessionFileVersion {
+		return nil, fmt.Errorf("%s has version %d of the session file format, this splicectl reads version %d", s.Path, sealed[0], sessionFileVersion)
+	}
+	header, sealed := sealed[:1+sessionSaltSize], sealed[1+sessionSaltSize:]
+	gcm, err := s.cipher(header[1:])
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("%s is not a session file", s.Path)
+	}
+	nonce, sealed := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	data, err := gcm.Open(nil, nonce, sealed, header)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt %s, is %s right: %v", s.Path, EnvSessionKey, err)
+	}
+	return data, nil
+}
+
+// cipher - the AES-GCM cipher of the key derived from the passphrase and salt
+func (s *EncryptedFileStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if len(s.Passphrase) == 0 {
+		return nil, fmt.Errorf("the %s session store requires a passphrase, set %s", StoreEncryptedFile, EnvSessionKey)
+	}
+	key, err := scrypt.Key([]byte(s.Passphrase), salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// EnvStore - the session given in SPLICECTL_SESSION_ID and
+// SPLICECTL_SESSION_VALID_UNTIL, for every key.  It can't save sessions.
+type EnvStore struct{}
+
+// Load - the session in the environment
+func (s *EnvStore) Load(key string) (common.SessionData, error) {
+	return common.SessionData{
+		SessionID:  os.Getenv(EnvSessionID),
+		ValidUntil: os.Getenv(EnvSessionValidUntil),
+	}, nil
+}
+
+// Save - always fails, the environment is set by the caller
+func (s *EnvStore) Save(key string, session common.SessionData) error {
+	return fmt.Errorf("%w, set %s and %s instead", ErrReadOnlyStore, EnvSessionID, EnvSessionValidUntil)
+}
+
+func loadSession(data []byte, key string) (common.SessionData, error) {
+	sessions := map[string]common.SessionData{}
+	if err := yaml.Unmarshal(data, &sessions); err != nil {
+		return common.SessionData{}, err
+	}
+	return sessions[key], nil
+}
+
+func saveSession(data []byte, key string, session common.SessionData) ([]byte, error) {
+	sessions := map[string]common.SessionData{}
+	if err := yaml.Unmarshal(data, &sessions); err != nil {
+		return nil, err
+	}
+	if len(session.SessionID) == 0 {
+		delete(sessions, key)
+	} else {
+		sessions[key] = session
+	}
+	return yaml.Marshal(sessions)
+}
+
+func writePrivate(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	// WriteFile only sets the mode of new files
+	if err := os.Chmod(path, 0600); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/splicemachine/splicectl/common"
+)
+
+func testStoreRoundTrip(t *testing.T, store SessionStore) {
+	session := common.SessionData{SessionID: "abc123", ValidUntil: "2030-01-02T03:04:05Z"}
+	if err := store.Save("dev", session); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := store.Save("context/prod", common.SessionData{SessionID: "prod"}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got, err := store.Load("dev"); err != nil || got != session {
+		t.Fatalf("expected %+v, got: %+v %v", session, got, err)
+	}
+	if err := store.Save("dev", common.SessionData{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if got, _ := store.Load("dev"); got != (common.SessionData{}) {
+		t.Fatalf("expected the session to be cleared, got: %+v", got)
+	}
+	if got, _ := store.Load("context/prod"); got.SessionID != "prod" {
+		t.Fatalf("expected the other sessions to be kept, got: %+v", got)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "splicectl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewSessionStore(StoreFile, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := store.Load("dev"); err != nil || got != (common.SessionData{}) {
+		t.Fatalf("expected an empty session before the first save, got: %+v %v", got, err)
+	}
+	testStoreRoundTrip(t, store)
+
+	stat, err := os.Stat(filepath.Join(dir, "sessions.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.Mode().Perm() != 0600 {
+		t.Fatalf("expected mode 0600, got: %v", stat.Mode().Perm())
+	}
+}
+
+func TestEncryptedFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "splicectl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := &EncryptedFileStore{Path: filepath.Join(dir, "sessions.enc"), Passphrase: "secret"}
+	testStoreRoundTrip(t, store)
+
+	sealed, err := ioutil.ReadFile(store.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(sealed, []byte("prod")) {
+		t.Fatalf("expected the session file to be encrypted")
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Fatalf("expected no key file next to the sessions, got: %d files", len(files))
+	}
+
+	wrong := &EncryptedFileStore{Path: store.Path, Passphrase: "wrong"}
+	if _, err := wrong.Load("context/prod"); err == nil {
+		t.Fatalf("expected an error decrypting with the wrong passphrase")
+	}
+
+	missing := &EncryptedFileStore{Path: store.Path}
+	if _, err := missing.Load("context/prod"); err == nil {
+		t.Fatalf("expected an error loading without a passphrase")
+	}
+	if err := missing.Save("dev", common.SessionData{SessionID: "abc123"}); err == nil {
+		t.Fatalf("expected an error saving without a passphrase")
+	}
+}
+
+func TestEncryptedFileStoreSalt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "splicectl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	session := common.SessionData{SessionID: "abc123"}
+	var headers [][]byte
+	for _, name := range []string{"one.enc", "two.enc"} {
+		store := &EncryptedFileStore{Path: filepath.Join(dir, name), Passphrase: "secret"}
+		if err := store.Save("dev", session); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		sealed, err := ioutil.ReadFile(store.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		headers = append(headers, sealed[:1+sessionSaltSize])
+	}
+	if bytes.Equal(headers[0], headers[1]) {
+		t.Fatalf("expected a different salt for each file, got: %x", headers[0])
+	}
+
+	store := &EncryptedFileStore{Path: filepath.Join(dir, "one.enc"), Passphrase: "secret"}
+	sealed, _ := ioutil.ReadFile(store.Path)
+	sealed[1] ^= 0xff
+	if err := ioutil.WriteFile(store.Path, sealed, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Load("dev"); err == nil {
+		t.Fatalf("expected an error reading a tampered salt")
+	}
+}
+
+func TestWritePrivateExistingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "splicectl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "sessions.yml")
+	if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := writePrivate(path, []byte("{}")); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if stat, _ := os.Stat(path); stat.Mode().Perm() != 0600 {
+		t.Fatalf("expected mode 0600, got: %v", stat.Mode().Perm())
+	}
+}
+
+func TestEnvStore(t *testing.T) {
+	os.Setenv(EnvSessionID, "from-env")
+	os.Setenv(EnvSessionValidUntil, "2030-01-02T03:04:05Z")
+	defer os.Unsetenv(EnvSessionID)
+	defer os.Unsetenv(EnvSessionValidUntil)
+
+	store, err := NewSessionStore(StoreEnv, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.Load("anything")
+	if err != nil || got.SessionID != "from-env" || got.ValidUntil != "2030-01-02T03:04:05Z" {
+		t.Fatalf("expected the session from the environment, got: %+v %v", got, err)
+	}
+	if err := store.Save("dev", got); !errors.Is(err, ErrReadOnlyStore) {
+		t.Fatalf("expected ErrReadOnlyStore, got: %v", err)
+	}
+}
+
+func TestNewSessionStoreUnknown(t *testing.T) {
+	if _, err := NewSessionStore("keychain", ""); err == nil {
+		t.Fatalf("expected an error for an unknown store")
+	}
+}
@@ -0,0 +1,142 @@
+// Package client provides a typed client for the splicectl API server.  Each
+// endpoint is exposed twice, once returning the raw response body (the *Raw
+// methods) and once returning the decoded structures from cmd/objects.
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+// TokenSource - provides the session credentials sent with each request,
+// auth.Client satisfies this interface.
+type TokenSource interface {
+	GetTokenBearer() string
+	GetSessionID() string
+}
+
+// Client - splicectl API client
+type Client struct {
+	server string
+	auth   TokenSource
+	rest   *resty.Client
+	// cas - the vault version writes are expected to replace, 0 to disable
+	cas int
+}
+
+// New - return a client for the API server at server, ie: https://host.domain.name
+// caBundle is an optional PEM encoded CA used to validate the server certificate
+// and auth may be nil for the unauthenticated endpoints (auth, version).
+func New(server string, caBundle string, auth TokenSource) *Client {
+	restClient := resty.New()
+	// Check if we've set a caBundle (via --ca-cert parameter)
+	if len(caBundle) > 0 {
+		roots := x509.NewCertPool()
+		ok := roots.AppendCertsFromPEM([]byte(caBundle))
+		if !ok {
+			logrus.Info("Failed to parse CABundle")
+		}
+		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+	}
+
+	return &Client{
+		server: server,
+		auth:   auth,
+		rest:   restClient,
+	}
+}
+
+// SetAuth - set the credentials used for authenticated requests
+func (c *Client) SetAuth(auth TokenSource) {
+	c.auth = auth
+}
+
+// WithExpectedVersion - return a copy of the client whose writes ask the
+// server for a Vault check-and-set against version, the write is refused
+// with a VersionConflictError when the stored version has moved on.
+func (c *Client) WithExpectedVersion(version int) *Client {
+	casClient := *c
+	casClient.cas = version
+	return &casClient
+}
+
+// Server - return the base URI of the API server
+func (c *Client) Server() string {
+	return c.server
+}
+
+func (c *Client) do(method string, uri string, body []byte, authenticated bool) ([]byte, error) {
+	req := c.rest.R()
+	// The LIST verb is not sent with content headers, the server rejects them
+	if method != "LIST" {
+		req.SetHeader("Content-Type", "application/json").
+			SetHeader("Accept", "application/json")
+	}
+	if authenticated && c.auth != nil {
+		req.SetHeader("X-Token-Bearer", c.auth.GetTokenBearer()).
+			SetHeader("X-Token-Session", c.auth.GetSessionID())
+	}
+	if body != nil {
+		req.SetBody(body)
+	}
+	if c.cas > 0 && method == resty.MethodPost {
+		req.SetQueryParam("cas", strconv.Itoa(c.cas))
+	}
+
+	resp, err := req.Execute(method, fmt.Sprintf("%s/%s", c.server, uri))
+	if err != nil {
+		return nil, &RequestError{Method: method, Path: uri, Err: err}
+	}
+	if resp.StatusCode() < 200 || resp.StatusCode() > 299 {
+		apiErr := newAPIError(method, uri, resp.StatusCode(), resp.Body())
+		if c.cas > 0 && apiErr.IsConflict() {
+			return nil, &VersionConflictError{Expected: c.cas, Err: apiErr}
+		}
+		return nil, apiErr
+	}
+
+	return resp.Body(), nil
+}
+
+// endpoint - the uri of path with the query escaped, so names holding &, #,
+// + or spaces reach the server unchanged
+func endpoint(path string, query url.Values) string {
+	return path + "?" + query.Encode()
+}
+
+func (c *Client) get(uri string) ([]byte, error) {
+	return c.do(resty.MethodGet, uri, nil, true)
+}
+
+func (c *Client) post(uri string, body []byte) ([]byte, error) {
+	return c.do(resty.MethodPost, uri, body, true)
+}
+
+func (c *Client) delete(uri string) ([]byte, error) {
+	return c.do(resty.MethodDelete, uri, nil, true)
+}
+
+// CheckVersion - compare the expected version with the latest of versions,
+// the same check the server makes for a check-and-set write, made up front
+// so servers that ignore the cas parameter still refuse stale writes.
+func CheckVersion(versions objects.VaultVersionList, expected int) error {
+	if latest := versions.Latest(); latest != expected {
+		return &VersionConflictError{Expected: expected, Current: latest}
+	}
+	return nil
+}
+
+func decode(raw []byte, v interface{}) error {
+	if err := json.Unmarshal(raw, v); err != nil {
+		return &DecodeError{Body: raw, Err: err}
+	}
+	return nil
+}
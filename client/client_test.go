@@ -0,0 +1,215 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+type testTokens struct{}
+
+func (testTokens) GetTokenBearer() string { return "bearer" }
+func (testTokens) GetSessionID() string   { return "session" }
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return New(server.URL, "", testTokens{})
+}
+
+func TestAuthenticationHeaders(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Token-Bearer") != "bearer" || r.Header.Get("X-Token-Session") != "session" {
+			t.Errorf("expected session headers, got: %v", r.Header)
+		}
+		fmt.Fprint(w, `{"data":{"key":"value"}}`)
+	})
+	cr, err := c.DefaultCR(0)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if _, ok := cr["data"]; !ok {
+		t.Fatalf("expected a top level data element, got: %v", cr)
+	}
+}
+
+func TestUnauthenticatedEndpoints(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Token-Bearer") != "" {
+			t.Errorf("expected no session headers on %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"SemVer":"v0.1.7","GitCommit":"abc","BuildDate":"today"}`+"\n")
+	})
+	version, err := c.ServerVersion()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if version.SemVer != "v0.1.7" {
+		t.Fatalf("expected SemVer v0.1.7, got: %s", version.SemVer)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/splicectl/v1/capabilities" || r.Header.Get("X-Token-Bearer") != "" {
+			t.Errorf("unexpected request: %s %v", r.URL.Path, r.Header)
+		}
+		fmt.Fprint(w, `{"features":{"get_default-cr":2,"pause":1}}`)
+	})
+	capabilities, err := c.Capabilities()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if capabilities.ResponseVersion("get_default-cr") != 2 || !capabilities.Supports("pause") || capabilities.Supports("delete") {
+		t.Fatalf("unexpected capabilities: %+v", capabilities)
+	}
+	if capabilities.Source != objects.CapabilitiesServer {
+		t.Fatalf("expected server capabilities, got: %s", capabilities.Source)
+	}
+}
+
+func TestDatabaseListVerb(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "LIST" {
+			t.Errorf("expected the LIST verb, got: %s", r.Method)
+		}
+		fmt.Fprint(w, `{"clusters":[{"dcosAppId":"splicedb","status":"Active"}]}`)
+	})
+	dbList, err := c.DatabaseList()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(dbList.Clusters) != 1 || dbList.Clusters[0].DcosAppId != "splicedb" {
+		t.Fatalf("unexpected workspace list: %+v", dbList)
+	}
+}
+
+func TestVaultVersions(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"2":{"created_time":"b"},"1":{"created_time":"a"}}`)
+	})
+	versions, err := c.DefaultCRVersions()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(versions.Versions) != 2 || versions.Versions[0].Version != 1 {
+		t.Fatalf("expected versions sorted ascending, got: %+v", versions)
+	}
+}
+
+func TestQueryEscaping(t *testing.T) {
+	keyPath := "secret/a&b #c+d"
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("keypath"); got != keyPath {
+			t.Errorf("expected keypath %q, got: %q", keyPath, got)
+		}
+		fmt.Fprint(w, `{}`)
+	})
+	if _, err := c.VaultKeyRaw(keyPath, 0); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestPauseBody(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != `{"appId":"splicedb","message":"say \"hi\""}` {
+			t.Errorf("unexpected request body: %s", body)
+		}
+		fmt.Fprint(w, `{"Process":"pause","Success":true,"database":"splicedb"}`)
+	})
+	status, err := c.PauseDatabase("splicedb", `say "hi"`)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !status.Success {
+		t.Fatalf("expected a successful action status, got: %+v", status)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `not json`)
+	})
+	_, err := c.Accounts()
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected a DecodeError, got: %v", err)
+	}
+}
+
+func TestRequestError(t *testing.T) {
+	c := New("http://127.0.0.1:1", "", nil)
+	_, err := c.SystemSettings(0)
+	var requestErr *RequestError
+	if !errors.As(err, &requestErr) {
+		t.Fatalf("expected a RequestError, got: %v", err)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":"database not found"}`)
+	})
+	_, err := c.DatabaseCR("missing", 0)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got: %v", err)
+	}
+	if !apiErr.IsNotFound() || apiErr.Message != "database not found" {
+		t.Fatalf("unexpected APIError: %+v", apiErr)
+	}
+	if apiErr.Path != "splicectl/v1/vault/databasecr?database-name=missing&version=0" {
+		t.Fatalf("expected the request path in the error, got: %s", apiErr.Path)
+	}
+}
+
+func TestAPIErrorPlainText(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	_, err := c.Accounts()
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got: %v", err)
+	}
+	if !apiErr.IsServerError() || apiErr.Message != "Internal Server Error" {
+		t.Fatalf("unexpected APIError: %+v", apiErr)
+	}
+}
+
+func TestExpectedVersion(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cas") != "7" {
+			t.Errorf("expected cas=7, got: %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"check-and-set parameter did not match the current version"}`)
+	})
+	_, err := c.WithExpectedVersion(7).SetDefaultCR([]byte(`{"data":{}}`))
+	var conflictErr *VersionConflictError
+	if !errors.As(err, &conflictErr) || conflictErr.Expected != 7 {
+		t.Fatalf("expected a VersionConflictError, got: %v", err)
+	}
+	if c.cas != 0 {
+		t.Fatalf("expected the original client to be unchanged, got cas=%d", c.cas)
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	versions := objects.VaultVersionList{Versions: []objects.VaultVersion{{Version: 9}, {Version: 7}, {Version: 8}}}
+	if err := CheckVersion(versions, 9); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	err := CheckVersion(versions, 7)
+	var conflictErr *VersionConflictError
+	if !errors.As(err, &conflictErr) || conflictErr.Current != 9 {
+		t.Fatalf("expected a VersionConflictError at version 9, got: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RequestError - the request could not be completed, the API server was not
+// reachable or the connection failed.
+type RequestError struct {
+	Method string
+	Path   string
+	Err    error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("%s %s: %v", e.Method, e.Path, e.Err)
+}
+
+// Unwrap - return the underlying transport error
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError - the response body could not be decoded into the expected
+// structure.
+type DecodeError struct {
+	Body []byte
+	Err  error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("could not decode response: %v", e.Err)
+}
+
+// Unwrap - return the underlying decoding error
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// APIError - the API server answered with a non-2xx status code.  Message is
+// taken from the error payload returned by the server when one is present.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Method     string
+	Path       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// IsNotFound - the requested object does not exist on the server
+func (e *APIError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// IsUnauthorized - the session is missing, expired or lacks permission
+func (e *APIError) IsUnauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+}
+
+// IsConflict - the write was refused because the object changed, Vault
+// reports a failed check-and-set as a 400 with a "check-and-set" message.
+func (e *APIError) IsConflict() bool {
+	return e.StatusCode == http.StatusConflict || strings.Contains(strings.ToLower(e.Message), "check-and-set")
+}
+
+// IsServerError - the server failed to process a valid request
+func (e *APIError) IsServerError() bool {
+	return e.StatusCode >= http.StatusInternalServerError
+}
+
+// VersionConflictError - the stored object is no longer at the version the
+// write expected, someone else changed it.  Current is 0 when the server
+// refused the write without reporting the current version.
+type VersionConflictError struct {
+	Expected int
+	Current  int
+	Err      error
+}
+
+func (e *VersionConflictError) Error() string {
+	if e.Current > 0 {
+		return fmt.Sprintf("version conflict: expected version %d but the current version is %d, re-read the object and try again", e.Expected, e.Current)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("version conflict: the object is no longer at version %d, re-read the object and try again: %v", e.Expected, e.Err)
+	}
+	return fmt.Sprintf("version conflict: the object is no longer at version %d, re-read the object and try again", e.Expected)
+}
+
+// Unwrap - return the error reported by the server, if any
+func (e *VersionConflictError) Unwrap() error {
+	return e.Err
+}
+
+// newAPIError - build an APIError from the response, the server reports
+// failures as {"error": "..."} or {"message": "..."} but older releases
+// return plain text.
+func newAPIError(method string, path string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Path:       path,
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		for _, key := range []string{"error", "Error", "message", "Message"} {
+			if msg, ok := payload[key].(string); ok && len(msg) > 0 {
+				apiErr.Message = msg
+				return apiErr
+			}
+		}
+	}
+
+	apiErr.Message = strings.TrimSpace(string(body))
+	if len(apiErr.Message) == 0 {
+		apiErr.Message = http.StatusText(statusCode)
+	}
+	return apiErr
+}
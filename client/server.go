@@ -0,0 +1,63 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/common"
+)
+
+// ServerVersionRaw - retrieve the version of the API server, no session is
+// required.
+func (c *Client) ServerVersionRaw() ([]byte, error) {
+	raw, err := c.do(resty.MethodGet, "splicectl", nil, false)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strings.TrimSuffix(string(raw), "\n")), nil
+}
+
+// ServerVersion - retrieve the version of the API server, no session is
+// required.
+func (c *Client) ServerVersion() (objects.BaseVersion, error) {
+	var version objects.BaseVersion
+	raw, err := c.ServerVersionRaw()
+	if err != nil {
+		return version, err
+	}
+	return version, decode(raw, &version)
+}
+
+// CapabilitiesRaw - retrieve the features the API server supports and the
+// response version of each, no session is required.  Servers older than the
+// endpoint answer with a 404.
+func (c *Client) CapabilitiesRaw() ([]byte, error) {
+	return c.do(resty.MethodGet, "splicectl/v1/capabilities", nil, false)
+}
+
+// Capabilities - retrieve the features the API server supports and the
+// response version of each, no session is required.
+func (c *Client) Capabilities() (objects.Capabilities, error) {
+	capabilities := objects.Capabilities{Source: objects.CapabilitiesServer}
+	raw, err := c.CapabilitiesRaw()
+	if err != nil {
+		return capabilities, err
+	}
+	return capabilities, decode(raw, &capabilities)
+}
+
+// AuthRaw - request a new session from the API server
+func (c *Client) AuthRaw() ([]byte, error) {
+	return c.do(resty.MethodGet, "splicectl/v1/auth", nil, false)
+}
+
+// Auth - request a new session from the API server
+func (c *Client) Auth() (common.SessionData, error) {
+	var session common.SessionData
+	raw, err := c.AuthRaw()
+	if err != nil {
+		return session, err
+	}
+	return session, decode(raw, &session)
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+func decodeActionStatus(raw []byte, err error) (objects.ActionStatus, error) {
+	var as objects.ActionStatus
+	if err != nil {
+		return as, err
+	}
+	return as, decode(raw, &as)
+}
+
+// DatabaseListRaw - retrieve the workspaces known to the cloud manager
+func (c *Client) DatabaseListRaw() ([]byte, error) {
+	return c.do("LIST", "splicectl/v1/splicedb/splicedatabase", nil, true)
+}
+
+// DatabaseList - retrieve the workspaces known to the cloud manager
+func (c *Client) DatabaseList() (objects.DatabaseList, error) {
+	var dbList objects.DatabaseList
+	raw, err := c.DatabaseListRaw()
+	if err != nil {
+		return dbList, err
+	}
+	return dbList, decode(raw, &dbList)
+}
+
+// CreateDatabaseRaw - request the creation of a new workspace
+func (c *Client) CreateDatabaseRaw(req *objects.DatabaseRequest) ([]byte, error) {
+	reqJSON, err := json.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return c.post("splicectl/v1/splicedb/splicedatabase", reqJSON)
+}
+
+// CreateDatabase - request the creation of a new workspace
+func (c *Client) CreateDatabase(req *objects.DatabaseRequest) (objects.ActionStatus, error) {
+	return decodeActionStatus(c.CreateDatabaseRaw(req))
+}
+
+// DeleteDatabaseRaw - delete the workspace with the given cluster id
+func (c *Client) DeleteDatabaseRaw(clusterID string) ([]byte, error) {
+	return c.delete(endpoint("splicectl/v1/splicedb/splicedatabasedelete", url.Values{"database-name": {clusterID}}))
+}
+
+// DeleteDatabase - delete the workspace with the given cluster id
+func (c *Client) DeleteDatabase(clusterID string) (objects.ActionStatus, error) {
+	return decodeActionStatus(c.DeleteDatabaseRaw(clusterID))
+}
+
+func workspaceMessage(databaseName string, message string) ([]byte, error) {
+	return json.Marshal(map[string]string{
+		"appId":   databaseName,
+		"message": message,
+	})
+}
+
+// PauseDatabaseRaw - pause a workspace, message is added to the workspace log
+func (c *Client) PauseDatabaseRaw(databaseName string, message string) ([]byte, error) {
+	body, err := workspaceMessage(databaseName, message)
+	if err != nil {
+		return nil, err
+	}
+	return c.post("splicectl/v1/splicedb/splicedatabasepause", body)
+}
+
+// PauseDatabase - pause a workspace, message is added to the workspace log
+func (c *Client) PauseDatabase(databaseName string, message string) (objects.ActionStatus, error) {
+	return decodeActionStatus(c.PauseDatabaseRaw(databaseName, message))
+}
+
+// ResumeDatabaseRaw - resume a paused workspace, message is added to the workspace log
+func (c *Client) ResumeDatabaseRaw(databaseName string, message string) ([]byte, error) {
+	body, err := workspaceMessage(databaseName, message)
+	if err != nil {
+		return nil, err
+	}
+	return c.post("splicectl/v1/splicedb/splicedatabaseresume", body)
+}
+
+// ResumeDatabase - resume a paused workspace, message is added to the workspace log
+func (c *Client) ResumeDatabase(databaseName string, message string) (objects.ActionStatus, error) {
+	return decodeActionStatus(c.ResumeDatabaseRaw(databaseName, message))
+}
+
+// RestartDatabaseRaw - restart the components of a workspace
+func (c *Client) RestartDatabaseRaw(databaseName string, force bool) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/splicedb/splicedatabaserestart", url.Values{"database-name": {databaseName}, "force": {strconv.FormatBool(force)}}), nil)
+}
+
+// RestartDatabase - restart the components of a workspace
+func (c *Client) RestartDatabase(databaseName string, force bool) (objects.ActionStatus, error) {
+	return decodeActionStatus(c.RestartDatabaseRaw(databaseName, force))
+}
+
+// DatabaseStatusRaw - retrieve the readiness of the components of a workspace
+func (c *Client) DatabaseStatusRaw(databaseName string) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/splicedb/splicedatabasestatus", url.Values{"database-name": {databaseName}}))
+}
+
+// DatabaseStatus - retrieve the readiness of the components of a workspace
+func (c *Client) DatabaseStatus(databaseName string) (objects.DatabaseStatus, error) {
+	var status objects.DatabaseStatus
+	raw, err := c.DatabaseStatusRaw(databaseName)
+	if err != nil {
+		return status, err
+	}
+	return status, decode(raw, &status)
+}
+
+// ImageTagsRaw - retrieve the image tags of a workspace component
+func (c *Client) ImageTagsRaw(componentName string, databaseName string) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/splicedb/imagetag", url.Values{"component-name": {componentName}, "database-name": {databaseName}}))
+}
+
+// ImageTags - retrieve the image tags of a workspace component
+func (c *Client) ImageTags(componentName string, databaseName string) (objects.ImageTagList, error) {
+	var tagList objects.ImageTagList
+	raw, err := c.ImageTagsRaw(componentName, databaseName)
+	if err != nil {
+		return tagList, err
+	}
+	return tagList, decode(raw, &tagList.ImageTags)
+}
+
+// SetImageTagRaw - set the image tag of a workspace component, the response
+// is not yet versioned by the server so only the raw body is offered.
+func (c *Client) SetImageTagRaw(componentName string, databaseName string, imageTag string) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/splicedb/imagetag",
+		url.Values{"component-name": {componentName}, "database-name": {databaseName}, "tag": {imageTag}}), nil)
+}
+
+// AccountsRaw - retrieve the cloud manager accounts
+func (c *Client) AccountsRaw() ([]byte, error) {
+	return c.get("splicectl/v1/cm/accounts")
+}
+
+// Accounts - retrieve the cloud manager accounts
+func (c *Client) Accounts() (objects.AccountList, error) {
+	var accounts objects.AccountList
+	raw, err := c.AccountsRaw()
+	if err != nil {
+		return accounts, err
+	}
+	return accounts, decode(raw, &accounts)
+}
@@ -0,0 +1,256 @@
+package client
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/common"
+)
+
+func decodeVaultVersion(raw []byte, err error) (objects.VaultVersion, error) {
+	var vv objects.VaultVersion
+	if err != nil {
+		return vv, err
+	}
+	return vv, decode(raw, &vv)
+}
+
+func decodeVaultVersions(raw []byte, err error) (objects.VaultVersionList, error) {
+	if err != nil {
+		return objects.VaultVersionList{}, err
+	}
+	vvl, rerr := common.RestructureVersions(string(raw))
+	if rerr != nil {
+		return vvl, &DecodeError{Body: raw, Err: rerr}
+	}
+	return vvl, nil
+}
+
+// DefaultCRRaw - retrieve the default CR, version 0 is the latest
+func (c *Client) DefaultCRRaw(version int) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/vault/defaultcr", url.Values{"version": {strconv.Itoa(version)}}))
+}
+
+// DefaultCR - retrieve the default CR, version 0 is the latest
+func (c *Client) DefaultCR(version int) (map[string]interface{}, error) {
+	raw, err := c.DefaultCRRaw(version)
+	if err != nil {
+		return nil, err
+	}
+	var cr map[string]interface{}
+	return cr, decode(raw, &cr)
+}
+
+// SetDefaultCRRaw - submit a new default CR
+func (c *Client) SetDefaultCRRaw(in []byte) ([]byte, error) {
+	return c.post("splicectl/v1/vault/defaultcr", in)
+}
+
+// SetDefaultCR - submit a new default CR, returns the new vault version
+func (c *Client) SetDefaultCR(in []byte) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.SetDefaultCRRaw(in))
+}
+
+// DefaultCRVersionsRaw - retrieve the vault versions of the default CR
+func (c *Client) DefaultCRVersionsRaw() ([]byte, error) {
+	return c.get("splicectl/v1/vault/defaultcrversions")
+}
+
+// DefaultCRVersions - retrieve the vault versions of the default CR
+func (c *Client) DefaultCRVersions() (objects.VaultVersionList, error) {
+	return decodeVaultVersions(c.DefaultCRVersionsRaw())
+}
+
+// RollbackDefaultCRRaw - restore the default CR to a previous vault version
+func (c *Client) RollbackDefaultCRRaw(version int) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/vault/rollbackdefaultcr", url.Values{"version": {strconv.Itoa(version)}}), nil)
+}
+
+// RollbackDefaultCR - restore the default CR to a previous vault version
+func (c *Client) RollbackDefaultCR(version int) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.RollbackDefaultCRRaw(version))
+}
+
+// DatabaseCRRaw - retrieve the CR of a workspace, version 0 is the latest
+func (c *Client) DatabaseCRRaw(databaseName string, version int) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/vault/databasecr", url.Values{"version": {strconv.Itoa(version)}, "database-name": {databaseName}}))
+}
+
+// DatabaseCR - retrieve the CR of a workspace, version 0 is the latest
+func (c *Client) DatabaseCR(databaseName string, version int) (objects.DatabaseCR, error) {
+	var cr objects.DatabaseCR
+	raw, err := c.DatabaseCRRaw(databaseName, version)
+	if err != nil {
+		return cr, err
+	}
+	return cr, decode(raw, &cr)
+}
+
+// SetDatabaseCRRaw - submit a new CR for a workspace
+func (c *Client) SetDatabaseCRRaw(databaseName string, in []byte) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/vault/databasecr", url.Values{"database-name": {databaseName}}), in)
+}
+
+// SetDatabaseCR - submit a new CR for a workspace, returns the new vault version
+func (c *Client) SetDatabaseCR(databaseName string, in []byte) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.SetDatabaseCRRaw(databaseName, in))
+}
+
+// DatabaseCRVersionsRaw - retrieve the vault versions of a workspace CR
+func (c *Client) DatabaseCRVersionsRaw(databaseName string) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/vault/databasecrversions", url.Values{"database-name": {databaseName}}))
+}
+
+// DatabaseCRVersions - retrieve the vault versions of a workspace CR
+func (c *Client) DatabaseCRVersions(databaseName string) (objects.VaultVersionList, error) {
+	return decodeVaultVersions(c.DatabaseCRVersionsRaw(databaseName))
+}
+
+// RollbackDatabaseCRRaw - restore a workspace CR to a previous vault version
+func (c *Client) RollbackDatabaseCRRaw(databaseName string, version int) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/vault/rollbackdatabasecr", url.Values{"version": {strconv.Itoa(version)}, "database-name": {databaseName}}), nil)
+}
+
+// RollbackDatabaseCR - restore a workspace CR to a previous vault version
+func (c *Client) RollbackDatabaseCR(databaseName string, version int) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.RollbackDatabaseCRRaw(databaseName, version))
+}
+
+// SystemSettingsRaw - retrieve the system settings, version 0 is the latest
+func (c *Client) SystemSettingsRaw(version int) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/vault/systemsettings", url.Values{"version": {strconv.Itoa(version)}}))
+}
+
+// SystemSettings - retrieve the system settings, version 0 is the latest
+func (c *Client) SystemSettings(version int) (objects.SystemSettings, error) {
+	var settings objects.SystemSettings
+	raw, err := c.SystemSettingsRaw(version)
+	if err != nil {
+		return settings, err
+	}
+	return settings, decode(raw, &settings)
+}
+
+// SetSystemSettingsRaw - submit new system settings
+func (c *Client) SetSystemSettingsRaw(in []byte) ([]byte, error) {
+	return c.post("splicectl/v1/vault/systemsettings", in)
+}
+
+// SetSystemSettings - submit new system settings, returns the new vault version
+func (c *Client) SetSystemSettings(in []byte) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.SetSystemSettingsRaw(in))
+}
+
+// SystemSettingsVersionsRaw - retrieve the vault versions of the system settings
+func (c *Client) SystemSettingsVersionsRaw() ([]byte, error) {
+	return c.get("splicectl/v1/vault/systemsettingsversions")
+}
+
+// SystemSettingsVersions - retrieve the vault versions of the system settings
+func (c *Client) SystemSettingsVersions() (objects.VaultVersionList, error) {
+	return decodeVaultVersions(c.SystemSettingsVersionsRaw())
+}
+
+// RollbackSystemSettingsRaw - restore the system settings to a previous vault version
+func (c *Client) RollbackSystemSettingsRaw(version int) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/vault/rollbacksystemsettings", url.Values{"version": {strconv.Itoa(version)}}), nil)
+}
+
+// RollbackSystemSettings - restore the system settings to a previous vault version
+func (c *Client) RollbackSystemSettings(version int) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.RollbackSystemSettingsRaw(version))
+}
+
+// CMSettingsRaw - retrieve the cloud manager settings for a component (ui|api),
+// version 0 is the latest
+func (c *Client) CMSettingsRaw(component string, version int) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/vault/cmsettings", url.Values{"component": {component}, "version": {strconv.Itoa(version)}}))
+}
+
+// CMSettings - retrieve the cloud manager settings for a component (ui|api),
+// version 0 is the latest
+func (c *Client) CMSettings(component string, version int) (objects.CMSettings, error) {
+	var settings objects.CMSettings
+	raw, err := c.CMSettingsRaw(component, version)
+	if err != nil {
+		return settings, err
+	}
+	return settings, decode(raw, &settings)
+}
+
+// SetCMSettingsRaw - submit new cloud manager settings for a component
+func (c *Client) SetCMSettingsRaw(component string, in []byte) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/vault/cmsettings", url.Values{"component": {component}}), in)
+}
+
+// SetCMSettings - submit new cloud manager settings for a component, returns
+// the new vault version
+func (c *Client) SetCMSettings(component string, in []byte) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.SetCMSettingsRaw(component, in))
+}
+
+// CMSettingsVersionsRaw - retrieve the vault versions of the cloud manager settings
+func (c *Client) CMSettingsVersionsRaw(component string) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/vault/cmsettingsversions", url.Values{"component": {component}}))
+}
+
+// CMSettingsVersions - retrieve the vault versions of the cloud manager settings
+func (c *Client) CMSettingsVersions(component string) (objects.VaultVersionList, error) {
+	return decodeVaultVersions(c.CMSettingsVersionsRaw(component))
+}
+
+// RollbackCMSettingsRaw - restore the cloud manager settings to a previous vault version
+func (c *Client) RollbackCMSettingsRaw(component string, version int) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/vault/rollbackcmsettings", url.Values{"component": {component}, "version": {strconv.Itoa(version)}}), nil)
+}
+
+// RollbackCMSettings - restore the cloud manager settings to a previous vault version
+func (c *Client) RollbackCMSettings(component string, version int) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.RollbackCMSettingsRaw(component, version))
+}
+
+// VaultKeyRaw - retrieve the data stored at a vault key path, version 0 is the latest
+func (c *Client) VaultKeyRaw(keyPath string, version int) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/vault/vaultkey", url.Values{"version": {strconv.Itoa(version)}, "keypath": {keyPath}}))
+}
+
+// VaultKey - retrieve the data stored at a vault key path, version 0 is the latest
+func (c *Client) VaultKey(keyPath string, version int) (map[string]interface{}, error) {
+	raw, err := c.VaultKeyRaw(keyPath, version)
+	if err != nil {
+		return nil, err
+	}
+	var data map[string]interface{}
+	return data, decode(raw, &data)
+}
+
+// SetVaultKeyRaw - submit new data to a vault key path
+func (c *Client) SetVaultKeyRaw(keyPath string, in []byte) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/vault/vaultkey", url.Values{"keypath": {keyPath}}), in)
+}
+
+// SetVaultKey - submit new data to a vault key path, returns the new vault version
+func (c *Client) SetVaultKey(keyPath string, in []byte) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.SetVaultKeyRaw(keyPath, in))
+}
+
+// VaultKeyVersionsRaw - retrieve the vault versions of a vault key path
+func (c *Client) VaultKeyVersionsRaw(keyPath string) ([]byte, error) {
+	return c.get(endpoint("splicectl/v1/vault/vaultkeyversions", url.Values{"keypath": {keyPath}}))
+}
+
+// VaultKeyVersions - retrieve the vault versions of a vault key path
+func (c *Client) VaultKeyVersions(keyPath string) (objects.VaultVersionList, error) {
+	return decodeVaultVersions(c.VaultKeyVersionsRaw(keyPath))
+}
+
+// RollbackVaultKeyRaw - restore a vault key path to a previous vault version
+func (c *Client) RollbackVaultKeyRaw(keyPath string, version int) ([]byte, error) {
+	return c.post(endpoint("splicectl/v1/vault/rollbackvaultkey", url.Values{"version": {strconv.Itoa(version)}, "keypath": {keyPath}}), nil)
+}
+
+// RollbackVaultKey - restore a vault key path to a previous vault version
+func (c *Client) RollbackVaultKey(keyPath string, version int) (objects.VaultVersion, error) {
+	return decodeVaultVersion(c.RollbackVaultKeyRaw(keyPath, version))
+}
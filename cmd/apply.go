@@ -1,21 +1,166 @@
 package cmd
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/common"
 )
 
 var applyCmd = &cobra.Command{
 	Use:   "apply",
-	Args:  cobra.MinimumNArgs(1),
 	Short: "Apply configurations to various resources of the Splice Machine Database Cluster",
 	Long: `EXAMPLES
 	splicectl get system-settings > ~/tmp/system-settings.json
 	# edit the file
 	splicectl apply system-settings --file ~/tmp/system-settings.json
+
+	# apply every manifest in a file or directory
+	splicectl apply -f ~/cluster-config/
+
+MANIFESTS
+	Each document names its kind and the metadata needed to locate it,
+	everything else is submitted as is.
+
+	kind: DatabaseCR        # DefaultCR|DatabaseCR|SystemSettings|CMSettings|VaultKey|ImageTag
+	metadata:
+	  database-name: splicedb   # DatabaseCR, ImageTag
+	  component: api            # CMSettings (ui|api), ImageTag
+	  keypath: path/to/key      # VaultKey
+	  expected-version: 7       # optional, only apply when the latest vault version is 7
+	data:
+	  ...
+	---
+	kind: ImageTag
+	metadata:
+	  database-name: splicedb
+	  component: hbase
+	tag: master-246
 	`,
-	Run: func(cmd *cobra.Command, args []string) {},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filePath, _ := cmd.Flags().GetString("filename")
+		if len(filePath) == 0 {
+			cmd.Help()
+			return nil
+		}
+
+		manifests, err := common.ReadManifests(filePath)
+		if err != nil {
+			return withMessage(err, "Error reading manifests")
+		}
+		if len(manifests) == 0 {
+			return fmt.Errorf("no manifests found in %s", filePath)
+		}
+
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			return runDiff(manifests)
+		}
+
+		var firstErr error
+		var results objects.ApplyResultList
+		for _, manifest := range manifests {
+			result := objects.ApplyResult{
+				Source: manifest.Source,
+				Kind:   manifest.Kind,
+				Name:   manifest.Name(),
+			}
+			version, err := applyManifest(manifest)
+			if err != nil {
+				logrus.WithError(err).Error(fmt.Sprintf("Error applying %s %s", manifest.Kind, manifest.Name()))
+				result.Error = err.Error()
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+			result.Version = version.Version
+			results.Results = append(results.Results, result)
+		}
+
+		if err := printObject(&results, "table"); err != nil {
+			return err
+		}
+		if firstErr != nil {
+			return withMessage(firstErr, "Not all manifests were applied")
+		}
+		return nil
+	},
+}
+
+// applyManifest - submit the manifest to the endpoint for its kind, the
+// vault version created is returned when the server's response has one.
+// ImageTag and the first response version of each feature have no version.
+func applyManifest(m objects.Manifest) (objects.VaultVersion, error) {
+	switch m.Kind {
+	case objects.KindImageTag:
+		if err := requireFeature("apply_image-tag"); err != nil {
+			return objects.VaultVersion{}, err
+		}
+		_, err := apiClient.SetImageTagRaw(m.Metadata.Component, m.Metadata.DatabaseName, m.Body["tag"].(string))
+		return objects.VaultVersion{}, err
+	}
+
+	body, err := json.Marshal(m.Body)
+	if err != nil {
+		return objects.VaultVersion{}, err
+	}
+	writer, err := writeClient(m)
+	if err != nil {
+		return objects.VaultVersion{}, err
+	}
+
+	var feature string
+	var write func() ([]byte, error)
+	switch m.Kind {
+	case objects.KindDefaultCR:
+		feature = "apply_default-cr"
+		write = func() ([]byte, error) { return writer.SetDefaultCRRaw(body) }
+	case objects.KindDatabaseCR:
+		feature = "apply_database-cr"
+		write = func() ([]byte, error) { return writer.SetDatabaseCRRaw(m.Metadata.DatabaseName, body) }
+	case objects.KindSystemSettings:
+		feature = "apply_system-settings"
+		write = func() ([]byte, error) { return writer.SetSystemSettingsRaw(body) }
+	case objects.KindCMSettings:
+		feature = "apply_cm-settings"
+		write = func() ([]byte, error) { return writer.SetCMSettingsRaw(strings.ToLower(m.Metadata.Component), body) }
+	case objects.KindVaultKey:
+		feature = "apply_vault-key"
+		write = func() ([]byte, error) {
+			return writer.SetVaultKeyRaw(strings.TrimPrefix(m.Metadata.KeyPath, "secrets/"), body)
+		}
+	default:
+		return objects.VaultVersion{}, errors.New("unknown kind " + m.Kind)
+	}
+
+	if err := requireFeature(feature); err != nil {
+		return objects.VaultVersion{}, err
+	}
+	if m.Kind == objects.KindDefaultCR {
+		if _, err := validateDefaultCR(body); err != nil {
+			return objects.VaultVersion{}, err
+		}
+	}
+	out, err := write()
+	if err != nil {
+		return objects.VaultVersion{}, err
+	}
+	// the first response version of the set commands is printed as is, see
+	// displayFeature, only the later ones are a vault version
+	var version objects.VaultVersion
+	if capabilities := serverCapabilities(); capabilities.ResponseVersion(feature) < 2 {
+		return version, nil
+	}
+	return version, decodeResponse(string(out), &version)
 }
 
 func init() {
 	rootCmd.AddCommand(applyCmd)
+
+	applyCmd.Flags().StringP("filename", "f", "", "Apply the manifests in a file or directory, - reads stdin")
+	applyCmd.Flags().Bool("dry-run", false, "Show the differences with the cluster without applying them")
 }
@@ -1,17 +1,11 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"os"
 	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
@@ -26,103 +20,62 @@ var applyCMSettingsCmd = &cobra.Command{
 	#edit file
 	splicectl apply cm-settings --component --file ~/tmp/cm-ui.json
 `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		component, _ := cmd.Flags().GetString("component")
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("apply_cm-settings")
-
 		component = strings.ToLower(component)
 		if len(component) == 0 || !strings.Contains("ui api", component) {
-			logrus.Fatal("--component needs to be 'ui' or 'api'")
+			return fmt.Errorf("--component needs to be 'ui' or 'api'")
 		}
 		filePath, _ := cmd.Flags().GetString("file")
 		fileBytes, _ := ioutil.ReadFile(filePath)
 
 		jsonBytes, cerr := common.WantJSON(fileBytes)
 		if cerr != nil {
-			logrus.Fatal("The input data MUST be in either JSON or YAML format")
+			return withMessage(cerr, "The input data MUST be in either JSON or YAML format")
 		}
 
-		out, err := setCMSettings(component, jsonBytes)
-		if err != nil {
-			logrus.WithError(err).Error("Error setting System Settings")
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			manifest, err := newManifest(objects.KindCMSettings, objects.ManifestMetadata{Component: component}, jsonBytes, filePath)
+			if err != nil {
+				return err
+			}
+			return runDiff([]objects.Manifest{manifest})
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.1.6"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayApplyCmSettingsV1(out)
-			}
+		writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindCMSettings, Metadata: objects.ManifestMetadata{Component: component}})
+		if err != nil {
+			return err
+		}
+		out, err := setCMSettings(writer, component, jsonBytes)
+		if err != nil {
+			return withMessage(err, "Error setting System Settings")
 		}
+
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayApplyCmSettingsV1(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
+func displayApplyCmSettingsV1(in string) error {
 	var vvData objects.VaultVersion
-	marshErr := json.Unmarshal([]byte(in), &vvData)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vvData.ToJSON()
-	case "gron":
-		vvData.ToGRON()
-	case "yaml":
-		vvData.ToYAML()
-	case "text", "table":
-		vvData.ToTEXT(noHeaders)
-	}
-
+	return displayResponse(in, &vvData, "text")
 }
 
-func setCMSettings(comp string, in []byte) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+func setCMSettings(writer *client.Client, comp string, in []byte) (string, error) {
+	out, err := writer.SetCMSettingsRaw(comp, in)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/vault/cmsettings?component=%s", comp)
-	resp, resperr := restClient.R().
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		SetBody(in).
-		SetResult(&AuthSuccess{}). // or SetResult(AuthSuccess{}).
-		SetError(&AuthError{}).    // or SetError(AuthError{}).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error setting System Settings")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	applyCmd.AddCommand(applyCMSettingsCmd)
+	declareFeature(applyCMSettingsCmd, "apply_cm-settings", displayApplyCmSettingsV1)
 
 	applyCMSettingsCmd.Flags().String("file", "", "Specify the input file")
 	applyCMSettingsCmd.Flags().StringP("component", "c", "", "Specify the component, <ui|api>")
 	applyCMSettingsCmd.MarkFlagRequired("file")
-
+	applyCMSettingsCmd.Flags().Bool("dry-run", false, "Show the differences with the cluster without applying them")
+	addExpectedVersionFlag(applyCMSettingsCmd)
 }
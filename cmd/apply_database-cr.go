@@ -1,17 +1,10 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"os"
-	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
@@ -34,17 +27,13 @@ var applyDatabaseCRCmd = &cobra.Command{
 	supplied will be used and a message will be displayed letting you know which
 	option was chosen if more than one were supplied.
 `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var dberr error
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("apply_database-cr")
-
 		databaseName := common.DatabaseName(cmd)
 		if len(databaseName) == 0 {
 			databaseName, dberr = promptForDatabaseName()
 			if dberr != nil {
-				logrus.Fatal("Could not get a list of Databases", dberr)
+				return withMessage(dberr, "Could not get a list of Databases")
 			}
 		}
 		filePath, _ := cmd.Flags().GetString("file")
@@ -52,98 +41,52 @@ var applyDatabaseCRCmd = &cobra.Command{
 
 		jsonBytes, cerr := common.WantJSON(fileBytes)
 		if cerr != nil {
-			logrus.Fatal("The input data MUST be in either JSON or YAML format")
-		}
-
-		out, err := setDatabaseCR(databaseName, jsonBytes)
-		if err != nil {
-			logrus.WithError(err).Error("Error setting Database CR Info")
+			return withMessage(cerr, "The input data MUST be in either JSON or YAML format")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.0.14 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayApplyDatabaseCRV1(out)
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			manifest, err := newManifest(objects.KindDatabaseCR, objects.ManifestMetadata{DatabaseName: databaseName}, jsonBytes, filePath)
+			if err != nil {
+				return err
 			}
+			return runDiff([]objects.Manifest{manifest})
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayApplyDatabaseCRV2(out)
-			}
+		writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindDatabaseCR, Metadata: objects.ManifestMetadata{DatabaseName: databaseName}})
+		if err != nil {
+			return err
+		}
+		out, err := setDatabaseCR(writer, databaseName, jsonBytes)
+		if err != nil {
+			return withMessage(err, "Error setting Database CR Info")
 		}
 
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayApplyDatabaseCRV1(in string) {
+func displayApplyDatabaseCRV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayApplyDatabaseCRV2(in string) {
-
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
+func displayApplyDatabaseCRV2(in string) error {
 	var vvData objects.VaultVersion
-	marshErr := json.Unmarshal([]byte(in), &vvData)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vvData.ToJSON()
-	case "gron":
-		vvData.ToGRON()
-	case "yaml":
-		vvData.ToYAML()
-	case "text", "table":
-		vvData.ToTEXT(noHeaders)
-	}
+	return displayResponse(in, &vvData, "text")
 }
 
-func setDatabaseCR(dbname string, in []byte) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+func setDatabaseCR(writer *client.Client, dbname string, in []byte) (string, error) {
+	out, err := writer.SetDatabaseCRRaw(dbname, in)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/vault/databasecr?database-name=%s", dbname)
-	resp, resperr := restClient.R().
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		SetBody(in).
-		SetResult(&AuthSuccess{}). // or SetResult(AuthSuccess{}).
-		SetError(&AuthError{}).    // or SetError(AuthError{}).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error setting Database CR Info")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	applyCmd.AddCommand(applyDatabaseCRCmd)
+	declareFeature(applyDatabaseCRCmd, "apply_database-cr", displayApplyDatabaseCRV1, displayApplyDatabaseCRV2)
 
 	// add database name and aliases
 	applyDatabaseCRCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
@@ -153,4 +96,6 @@ func init() {
 	applyDatabaseCRCmd.Flags().StringP("file", "f", "", "Specify the input file")
 	// applyDatabaseCRCmd.MarkFlagRequired("database-name")
 	applyDatabaseCRCmd.MarkFlagRequired("file")
+	applyDatabaseCRCmd.Flags().Bool("dry-run", false, "Show the differences with the cluster without applying them")
+	addExpectedVersionFlag(applyDatabaseCRCmd)
 }
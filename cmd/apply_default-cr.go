@@ -1,19 +1,13 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"os"
-	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 )
@@ -64,112 +58,64 @@ var applyDefaultCRCmd = &cobra.Command{
 	# edit file
 	splicectl apply default-cr --file ~/tmp/default-cr.json
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("apply_default-cr")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		filePath, _ := cmd.Flags().GetString("file")
 		fileBytes, _ := ioutil.ReadFile(filePath)
 
 		jsonBytes, cerr := common.WantJSON(fileBytes)
 		if cerr != nil {
-			logrus.Fatal("The input data MUST be in either JSON or YAML format")
+			return withMessage(cerr, "The input data MUST be in either JSON or YAML format")
 		}
 		if _, err := validateDefaultCR(jsonBytes); err != nil {
-			logrus.WithError(err).Fatal("Error validating Default CR")
-		}
-
-		out, err := setDefaultCR(jsonBytes)
-		if err != nil {
-			logrus.WithError(err).Error("Error setting Default CR Info")
+			return withMessage(err, "Error validating Default CR")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.0.14 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayApplyDefaultCRV1(out)
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			manifest, err := newManifest(objects.KindDefaultCR, objects.ManifestMetadata{}, jsonBytes, filePath)
+			if err != nil {
+				return err
 			}
+			return runDiff([]objects.Manifest{manifest})
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayApplyDefaultCRV2(out)
-			}
+		writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindDefaultCR})
+		if err != nil {
+			return err
 		}
+		out, err := setDefaultCR(writer, jsonBytes)
+		if err != nil {
+			return withMessage(err, "Error setting Default CR Info")
+		}
+
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayApplyDefaultCRV1(in string) {
+func displayApplyDefaultCRV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayApplyDefaultCRV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
+func displayApplyDefaultCRV2(in string) error {
 	var vvData objects.VaultVersion
-	marshErr := json.Unmarshal([]byte(in), &vvData)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vvData.ToJSON()
-	case "gron":
-		vvData.ToGRON()
-	case "yaml":
-		vvData.ToYAML()
-	case "text", "table":
-		vvData.ToTEXT(noHeaders)
-	}
-
+	return displayResponse(in, &vvData, "text")
 }
 
-func setDefaultCR(in []byte) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+func setDefaultCR(writer *client.Client, in []byte) (string, error) {
+	out, err := writer.SetDefaultCRRaw(in)
+	if err != nil {
+		return "", err
 	}
 
-	uri := "splicectl/v1/vault/defaultcr"
-	resp, resperr := restClient.R().
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		SetBody(in).
-		SetResult(&AuthSuccess{}). // or SetResult(AuthSuccess{}).
-		SetError(&AuthError{}).    // or SetError(AuthError{}).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error setting Default CR Info")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	applyCmd.AddCommand(applyDefaultCRCmd)
+	declareFeature(applyDefaultCRCmd, "apply_default-cr", displayApplyDefaultCRV1, displayApplyDefaultCRV2)
 
 	applyDefaultCRCmd.Flags().String("file", "", "Specify the input file")
 	applyDefaultCRCmd.MarkFlagRequired("file")
+	applyDefaultCRCmd.Flags().Bool("dry-run", false, "Show the differences with the cluster without applying them")
+	addExpectedVersionFlag(applyDefaultCRCmd)
 }
@@ -1,14 +1,9 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"os"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/cmd/objects"
 
 	"github.com/spf13/cobra"
 )
@@ -26,74 +21,54 @@ var applyImageTagCmd = &cobra.Command{
 		kafka
 		zookeeper
 `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var dberr error
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("apply_image-tag")
-
 		componentName, _ := cmd.Flags().GetString("component-name")
 		databaseName, _ := cmd.Flags().GetString("database-name")
 		if len(databaseName) == 0 {
 			databaseName, dberr = promptForDatabaseName()
 			if dberr != nil {
-				logrus.Fatal("Could not get a list of Databases", dberr)
+				return withMessage(dberr, "Could not get a list of Databases")
 			}
 		}
 
 		tag, _ := cmd.Flags().GetString("tag")
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			manifest := objects.Manifest{
+				Kind:     objects.KindImageTag,
+				Metadata: objects.ManifestMetadata{DatabaseName: databaseName, Component: componentName},
+				Body:     map[string]interface{}{"tag": tag},
+				Source:   "--tag",
+			}
+			return runDiff([]objects.Manifest{manifest})
+		}
+
 		out, err := setDatabaseImageTag(componentName, databaseName, tag)
 		if err != nil {
-			logrus.WithError(err).Error("Error getting image tag for component")
+			return withMessage(err, "Error getting image tag for component")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.0.16"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayApplyImageTagV1(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayApplyImageTagV1(in string) {
+func displayApplyImageTagV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
 func setDatabaseImageTag(componentName string, databaseName string, imageTag string) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
-	}
-
-	uri := fmt.Sprintf("splicectl/v1/splicedb/imagetag?component-name=%s&database-name=%s&tag=%s",
-		componentName, databaseName, imageTag)
-	resp, resperr := restClient.R().
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		SetResult(&AuthSuccess{}). // or SetResult(AuthSuccess{}).
-		SetError(&AuthError{}).    // or SetError(AuthError{}).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error setting TAG for database")
-		return "", resperr
+	out, err := apiClient.SetImageTagRaw(componentName, databaseName, imageTag)
+	if err != nil {
+		return "", err
 	}
 
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	applyCmd.AddCommand(applyImageTagCmd)
+	declareFeature(applyImageTagCmd, "apply_image-tag", displayApplyImageTagV1)
 
 	applyImageTagCmd.Flags().StringP("component-name", "c", "", "Specify the component")
 	applyImageTagCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
@@ -102,5 +77,5 @@ func init() {
 	applyImageTagCmd.MarkFlagRequired("component-name")
 	// applyImageTagCmd.MarkFlagRequired("database-name")
 	applyImageTagCmd.MarkFlagRequired("tag")
-
+	applyImageTagCmd.Flags().Bool("dry-run", false, "Show the differences with the cluster without applying them")
 }
@@ -1,6 +1,13 @@
 package cmd
 
-import "testing"
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
 
 const (
 	validDefaultCR       = `{"data":{"key":"value"}}`
@@ -27,3 +34,40 @@ func TestValidateDefaultCR(t *testing.T) {
 		t.Fatalf("Expected to get an error with doubleNestedDataJSON, but got none.")
 	}
 }
+
+func TestDiffDefaultCRMissingFile(t *testing.T) {
+	diffDefaultCRCmd.Flags().Set("file", "/nonexistent/default-cr.json")
+	defer diffDefaultCRCmd.Flags().Set("file", "")
+	err := diffDefaultCRCmd.RunE(diffDefaultCRCmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "Could not read the input file") {
+		t.Fatalf("expected the read error, got: %v", err)
+	}
+}
+
+func TestApplyManifestResponseVersion(t *testing.T) {
+	m := objects.Manifest{Kind: objects.KindDefaultCR, Body: map[string]interface{}{"data": map[string]interface{}{"key": "value"}}}
+	tests := []struct {
+		name     string
+		semVer   string
+		response string
+		version  int
+	}{
+		{"raw response", "v0.0.16", "Default CR saved", 0},
+		{"vault version", "v0.0.17", `{"version":4}`, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withServer(t, tt.semVer, func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasSuffix(r.URL.Path, "/capabilities") {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprint(w, tt.response)
+			})
+			version, err := applyManifest(m)
+			if err != nil || version.Version != tt.version {
+				t.Fatalf("expected version %d, got: %+v %v", tt.version, version, err)
+			}
+		})
+	}
+}
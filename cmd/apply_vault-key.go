@@ -1,17 +1,11 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"os"
 	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
@@ -26,12 +20,7 @@ var applyVaultKeyCmd = &cobra.Command{
 	# edit file
 	splicectl apply vault-key --keypath services/cloudmanager/config/default/ui --file ~/tmp/cm-ui.json
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("apply_vault-key")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		keyPath, _ := cmd.Flags().GetString("keypath")
 		if strings.HasPrefix(keyPath, "secrets/") {
 			keyPath = strings.TrimPrefix(keyPath, "secrets/")
@@ -41,101 +30,57 @@ var applyVaultKeyCmd = &cobra.Command{
 
 		jsonBytes, cerr := common.WantJSON(fileBytes)
 		if cerr != nil {
-			logrus.Fatal("The input data MUST be in either JSON or YAML format")
-		}
-
-		out, err := setVaultKeyData(keyPath, jsonBytes)
-		if err != nil {
-			logrus.WithError(err).Error("Error setting Vault-Key Data")
+			return withMessage(cerr, "The input data MUST be in either JSON or YAML format")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.0.14 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayApplyVaultKeyV1(out)
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			manifest, err := newManifest(objects.KindVaultKey, objects.ManifestMetadata{KeyPath: keyPath}, jsonBytes, filePath)
+			if err != nil {
+				return err
 			}
+			return runDiff([]objects.Manifest{manifest})
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayApplyVaultKeyV2(out)
-			}
+		writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindVaultKey, Metadata: objects.ManifestMetadata{KeyPath: keyPath}})
+		if err != nil {
+			return err
+		}
+		out, err := setVaultKeyData(writer, keyPath, jsonBytes)
+		if err != nil {
+			return withMessage(err, "Error setting Vault-Key Data")
 		}
+
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayApplyVaultKeyV1(in string) {
+func displayApplyVaultKeyV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayApplyVaultKeyV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
-
+func displayApplyVaultKeyV2(in string) error {
 	var vvData objects.VaultVersion
-	marshErr := json.Unmarshal([]byte(in), &vvData)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vvData.ToJSON()
-	case "gron":
-		vvData.ToGRON()
-	case "yaml":
-		vvData.ToYAML()
-	case "text", "table":
-		vvData.ToTEXT(noHeaders)
-	}
-
+	return displayResponse(in, &vvData, "text")
 }
 
-func setVaultKeyData(keypath string, in []byte) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
-	}
-
-	uri := fmt.Sprintf("splicectl/v1/vault/vaultkey?keypath=%s", keypath)
-	resp, resperr := restClient.R().
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		SetBody(in).
-		SetResult(&AuthSuccess{}). // or SetResult(AuthSuccess{}).
-		SetError(&AuthError{}).    // or SetError(AuthError{}).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error setting Default CR Info")
-		return "", resperr
+func setVaultKeyData(writer *client.Client, keypath string, in []byte) (string, error) {
+	out, err := writer.SetVaultKeyRaw(keypath, in)
+	if err != nil {
+		return "", err
 	}
 
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	applyCmd.AddCommand(applyVaultKeyCmd)
+	declareFeature(applyVaultKeyCmd, "apply_vault-key", displayApplyVaultKeyV1, displayApplyVaultKeyV2)
 
 	applyVaultKeyCmd.Flags().String("keypath", "", "Specify the vault key path")
 	applyVaultKeyCmd.Flags().String("file", "", "Specify the input file")
 	applyVaultKeyCmd.MarkFlagRequired("keypath")
 	applyVaultKeyCmd.MarkFlagRequired("file")
+	applyVaultKeyCmd.Flags().Bool("dry-run", false, "Show the differences with the cluster without applying them")
+	addExpectedVersionFlag(applyVaultKeyCmd)
 }
@@ -1,93 +1,97 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
-	"fmt"
+	"time"
 
-	"github.com/spf13/viper"
-
-	"github.com/go-resty/resty/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/splicemachine/splicectl/auth"
+	"github.com/splicemachine/splicectl/common"
 )
 
-// SessionData - Session Authorization Info
-type SessionData struct {
-	SessionID  string `json:"session_id"`
-	ValidUntil string `json:"valid_until"`
-}
+// defaultAutoRenewBefore - with --auto-renew, sessions expiring sooner than
+// this are renewed
+const defaultAutoRenewBefore = 10 * time.Minute
 
 var authCmd = &cobra.Command{
 	Use:   "auth",
 	Short: "Request an auth session",
 	Long: `EXAMPLES
-	splicectl auth`,
+	splicectl auth
+	splicectl auth status
+	splicectl auth logout
+
+	Sessions expire, unattended jobs can renew them before they do with the
+	global --auto-renew flag, or auto-renew: true in the config file.  The
+	session is renewed when it expires within auto-renew-before (default 10m)
+	from the config file, ie: auto-renew-before: 30m`,
 	Aliases: []string{"login"},
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 
-		// var authClient auth.Client
-		// environment := getEnvironmentName()
-		// authClient = auth.NewAuth(environment, common.SessionData{
-		// 	SessionID:  fmt.Sprintf("%s", viper.Get(fmt.Sprintf("%s-session_id", environment))),
-		// 	ValidUntil: fmt.Sprintf("%s", viper.Get(fmt.Sprintf("%s-valid_until", environment))),
-		// })
-		if pass := authClient.CheckTokenValidity(); pass {
-			sessData, err := json.Marshal(authClient.GetSession())
-			if err != nil {
-				logrus.WithError(err).Error("Error converting session data to JSON")
-				return
-			}
-			fmt.Println(string(sessData[:]))
-		} else {
-			out, err := performAuth()
-			if err != nil {
-				logrus.WithError(err).Error("Error getting AUTH Info")
-			}
-			var response SessionData
-			marsherr := json.Unmarshal([]byte(out), &response)
-			if marsherr != nil {
-				logrus.WithError(marsherr).Error("Error decoding json")
-			}
-			environment := getEnvironmentName()
-			viper.Set(fmt.Sprintf("%s-session_id", environment), response.SessionID)
-			viper.Set(fmt.Sprintf("%s-valid_until", environment), response.ValidUntil)
-			verr := viper.WriteConfig()
-			if verr != nil {
-				logrus.WithError(verr).Info("Failed to write config")
-			}
-			fmt.Println(out)
+		// an error retrieving the token bearer of the stored session is
+		// fixed by requesting a new session
+		if pass, _ := authClient.CheckTokenValidity(); pass {
+			return printObject(authClient.GetSession(), "json")
 		}
-
+		session, err := renewSession(environmentName)
+		if err != nil {
+			return withMessage(err, "Error getting AUTH Info")
+		}
+		return printObject(session, "json")
 	},
 }
 
 func performAuth() (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+	out, err := apiClient.AuthRaw()
+	if err != nil {
+		return "", err
 	}
 
-	uri := "splicectl/v1/auth"
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
+	return string(out[:]), nil
+}
 
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Default CR Info")
-		return "", resperr
+// renewSession - request a new session, store it and use it for the rest of
+// the command
+func renewSession(environment string) (common.SessionData, error) {
+	var session common.SessionData
+	out, err := performAuth()
+	if err != nil {
+		return session, err
+	}
+	if err := decodeResponse(out, &session); err != nil {
+		return session, err
 	}
 
-	return string(resp.Body()[:]), nil
+	if err := storeSession(environment, session.SessionID, session.ValidUntil); err != nil {
+		logrus.WithError(err).Info("Failed to write config")
+	}
+	provider, err := newBearerProvider(environment)
+	if err != nil {
+		return session, err
+	}
+	authClient = auth.NewAuth(environment, provider, session)
+	apiClient.SetAuth(authClient)
+	return session, nil
+}
 
+// autoRenew - renew the session when auto renew is enabled and the session
+// expires soon, or has expired
+func autoRenew(session common.SessionData) {
+	if !autoRenewSession && !viper.GetBool("auto-renew") {
+		return
+	}
+	renewBefore := defaultAutoRenewBefore
+	if viper.IsSet("auto-renew-before") {
+		renewBefore = viper.GetDuration("auto-renew-before")
+	}
+	if session.Remaining() > renewBefore {
+		return
+	}
+	logrus.Info("The session expires soon, requesting a new session")
+	if _, err := renewSession(environmentName); err != nil {
+		logrus.WithError(err).Warn("Could not renew the session")
+	}
 }
 
 func init() {
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var authLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Clear the stored session",
+	Long: `EXAMPLES
+	splicectl auth logout
+	splicectl auth logout --context prod
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := storeSession(environmentName, "", ""); err != nil {
+			return withMessage(err, "Error clearing the session")
+		}
+		logrus.Info("The session has been cleared")
+		return nil
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authLogoutCmd)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the stored session, when it expires and the time remaining",
+	Long: `EXAMPLES
+	splicectl auth status
+	splicectl auth status -o json
+
+	Exits with 3 when there is no session, or it has expired.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		session := authClient.GetSession()
+		remaining := session.Remaining()
+		status := objects.AuthStatus{
+			Environment: environmentName,
+			SessionID:   session.SessionID,
+			ValidUntil:  session.ValidUntil,
+			Remaining:   remaining.Truncate(time.Second).String(),
+			Valid:       len(session.SessionID) > 0 && remaining > 0,
+		}
+		if activeContext != nil {
+			status.Context = activeContext.Name
+		}
+		if err := printObject(&status, "table"); err != nil {
+			return err
+		}
+		if !status.Valid {
+			logrus.Info("There is no valid session, please run 'splicectl auth'")
+			return &exitStatus{code: exitUnauthorized}
+		}
+		return nil
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authStatusCmd)
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+	"github.com/splicemachine/splicectl/auth"
+)
+
+// newBearerProvider - the exec plugin of the bearer-exec config key when it
+// is set, otherwise the splicectl-api-tokens secret
+func newBearerProvider(environment string) (auth.BearerProvider, error) {
+	if !viper.IsSet("bearer-exec") {
+		return &auth.SecretProvider{Kube: kubeFactory}, nil
+	}
+	var execConfig auth.ExecConfig
+	if err := viper.UnmarshalKey("bearer-exec", &execConfig); err != nil {
+		return nil, fmt.Errorf("could not read bearer-exec from the config file: %v", err)
+	}
+	return &auth.ExecProvider{Config: execConfig, Environment: environment}, nil
+}
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/common"
+	"github.com/splicemachine/splicectl/printer"
+)
+
+// defaultBulkConcurrency - how many workspaces a bulk operation changes at
+// once when --concurrency isn't given
+const defaultBulkConcurrency = 4
+
+// addSelectionFlags - the flags selecting the workspaces of pause, resume,
+// restart workspace and delete
+func addSelectionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayP("database-name", "d", nil, "Specify the database name, repeat it or use a glob pattern, ie: dev-*, to select several")
+	cmd.Flags().String("database", "", "Alias for database-name, prefer the use of -d and --database-name.")
+	cmd.Flags().String("workspace", "", "Alias for database-name, prefer the use of -d and --database-name.")
+	cmd.Flags().Bool("all", false, "Select every workspace")
+	cmd.Flags().StringP("selector", "l", "", "Select the workspaces matching name, status, namespace, account or free-tier, ie: status=Active,namespace!=prod*")
+	cmd.Flags().Int("concurrency", defaultBulkConcurrency, "How many workspaces are changed at once")
+}
+
+// addConfirmFlag - the --yes flag of the bulk operations that ask for a
+// confirmation before changing the selected workspaces, see confirmBulk
+func addConfirmFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolP("yes", "y", false, "Don't ask for a confirmation before changing the selected workspaces")
+}
+
+// workspaceSelection - the workspaces selected by -d, --all and --selector
+type workspaceSelection struct {
+	names    []string
+	all      bool
+	selector objects.WorkspaceSelector
+}
+
+// newWorkspaceSelection - read the selection flags of cmd
+func newWorkspaceSelection(cmd *cobra.Command) (workspaceSelection, error) {
+	selection := workspaceSelection{names: common.DatabaseNames(cmd)}
+	selection.all, _ = cmd.Flags().GetBool("all")
+	spec, _ := cmd.Flags().GetString("selector")
+	selector, err := objects.ParseWorkspaceSelector(spec)
+	if err != nil {
+		return selection, err
+	}
+	selection.selector = selector
+	if selection.all && len(selection.names) > 0 {
+		return selection, errors.New("--all can't be combined with --database-name, use --selector to narrow it down")
+	}
+	return selection, nil
+}
+
+// bulk - the selection may hold more than one workspace
+func (s workspaceSelection) bulk() bool {
+	if s.all || !s.selector.Empty() || len(s.names) > 1 {
+		return true
+	}
+	return len(s.names) == 1 && objects.IsPattern(s.names[0])
+}
+
+// name - the single workspace selected, empty when none was given
+func (s workspaceSelection) name() string {
+	if len(s.names) == 0 {
+		return ""
+	}
+	return s.names[0]
+}
+
+// resolve - the workspaces of list that are selected, and the names that
+// match no workspace at all
+func (s workspaceSelection) resolve(list *objects.DatabaseList) ([]objects.CMClusterInfo, []string) {
+	var selected []objects.CMClusterInfo
+	matchedNames := map[string]bool{}
+	for _, info := range list.Clusters {
+		named := s.all || len(s.names) == 0
+		for _, name := range s.names {
+			if objects.MatchPattern(name, info.DcosAppId) {
+				named = true
+				matchedNames[name] = true
+			}
+		}
+		if named && s.selector.Matches(info) {
+			selected = append(selected, info)
+		}
+	}
+
+	var missing []string
+	for _, name := range s.names {
+		if !matchedNames[name] {
+			missing = append(missing, name)
+		}
+	}
+	return selected, missing
+}
+
+// bulkAction - change one workspace of a bulk operation
+type bulkAction func(info objects.CMClusterInfo) objects.BulkResult
+
+// runBulk - run action on every selected workspace, --concurrency at a time,
+// and print the result of each.  Any workspace failing is an error.
+func runBulk(cmd *cobra.Command, selection workspaceSelection, action bulkAction) error {
+	dbList, err := apiClient.DatabaseList()
+	if err != nil {
+		return withMessage(err, "Error retrieving the workspace list")
+	}
+	selected, missing := selection.resolve(&dbList)
+	if len(selected) == 0 && len(missing) == 0 {
+		return errors.New("no workspaces match the selection")
+	}
+
+	if cmd.Flags().Lookup("yes") != nil && len(selected) > 0 {
+		if err := confirmBulk(cmd, selected); err != nil {
+			return err
+		}
+	}
+
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	results := make([]objects.BulkResult, len(selected))
+	limit := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, info := range selected {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(i int, info objects.CMClusterInfo) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			results[i] = action(info)
+		}(i, info)
+	}
+	wg.Wait()
+
+	resultList := objects.BulkResultList{}
+	for _, name := range missing {
+		resultList.Results = append(resultList.Results, objects.BulkResult{Database: name, Result: objects.ResultFailed, Message: "no workspace matches"})
+	}
+	resultList.Results = append(resultList.Results, results...)
+	if err := printObject(&resultList, "table"); err != nil {
+		return err
+	}
+	if failed := resultList.Failed(); failed > 0 {
+		logrus.Error(fmt.Sprintf("%d of %d workspaces failed", failed, len(resultList.Results)))
+		return &exitStatus{code: exitGeneral}
+	}
+	return nil
+}
+
+// confirmBulk - print the selected workspaces to stderr and, unless --yes
+// is given, ask whether to go on
+func confirmBulk(cmd *cobra.Command, selected []objects.CMClusterInfo) error {
+	preview := objects.DatabaseList{Clusters: selected}
+	if err := printer.WriteTable(os.Stderr, preview.Table(), printer.Options{NoHeaders: noHeaders}); err != nil {
+		return withMessage(err, "Error writing output")
+	}
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return nil
+	}
+	confirmed, err := confirmPrompt(fmt.Sprintf("%s the %d workspaces listed above?", strings.Title(cmd.Name()), len(selected)))
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return errors.New("cancelled, no workspaces were changed")
+	}
+	return nil
+}
+
+// skipResult - the workspace isn't in a state the action applies to
+func skipResult(info objects.CMClusterInfo, reason string) objects.BulkResult {
+	return objects.BulkResult{Database: info.DcosAppId, Result: objects.ResultSkipped, Message: reason}
+}
+
+// actionResult - the result of the request changing the workspace, waiting
+// for condition when --wait is given
+func actionResult(cmd *cobra.Command, info objects.CMClusterInfo, status objects.ActionStatus, err error, condition waitCondition) objects.BulkResult {
+	result := objects.BulkResult{Database: info.DcosAppId, Result: objects.ResultSucceeded}
+	if err == nil {
+		err = status.Err()
+	}
+	if err == nil {
+		err = waitForWorkspace(cmd, info.DcosAppId, condition)
+	}
+	if err != nil {
+		result.Result = objects.ResultFailed
+		result.Message = err.Error()
+	}
+	return result
+}
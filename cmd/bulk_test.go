@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+func newBulkCommand(t *testing.T, args ...string) *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	addSelectionFlags(cmd)
+	addWaitFlags(cmd)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	return cmd
+}
+
+func TestWorkspaceSelection(t *testing.T) {
+	list := &objects.DatabaseList{Clusters: []objects.CMClusterInfo{
+		{DcosAppId: "dev-one", Namespace: "dev", Status: "Active"},
+		{DcosAppId: "dev-two", Namespace: "dev", Status: "Paused"},
+		{DcosAppId: "splicedb", Namespace: "prod", Status: "Active"},
+	}}
+
+	tests := []struct {
+		args     []string
+		bulk     bool
+		selected string
+		missing  string
+	}{
+		{[]string{"-d", "splicedb"}, false, "[splicedb]", "[]"},
+		{[]string{"-d", "dev-*"}, true, "[dev-one dev-two]", "[]"},
+		{[]string{"-d", "dev-one", "-d", "missing"}, true, "[dev-one]", "[missing]"},
+		{[]string{"--all"}, true, "[dev-one dev-two splicedb]", "[]"},
+		{[]string{"--all", "-l", "namespace!=prod"}, true, "[dev-one dev-two]", "[]"},
+		{[]string{"-l", "status=Active"}, true, "[dev-one splicedb]", "[]"},
+		{[]string{"-d", "dev-*", "-l", "status=Active"}, true, "[dev-one]", "[]"},
+	}
+	for _, tt := range tests {
+		selection, err := newWorkspaceSelection(newBulkCommand(t, tt.args...))
+		if err != nil {
+			t.Fatalf("%v: expected no error, got: %v", tt.args, err)
+		}
+		if selection.bulk() != tt.bulk {
+			t.Errorf("%v: expected bulk %t", tt.args, tt.bulk)
+		}
+		selected, missing := selection.resolve(list)
+		var names []string
+		for _, info := range selected {
+			names = append(names, info.DcosAppId)
+		}
+		if fmt.Sprint(names) != tt.selected || fmt.Sprint(missing) != tt.missing {
+			t.Errorf("%v: expected %s and missing %s, got: %v and %v", tt.args, tt.selected, tt.missing, names, missing)
+		}
+	}
+
+	if _, err := newWorkspaceSelection(newBulkCommand(t, "--all", "-d", "splicedb")); err == nil {
+		t.Fatalf("expected an error combining --all and -d")
+	}
+}
+
+func TestRunBulk(t *testing.T) {
+	withServer(t, "v0.1.7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"clusters":[{"dcosAppId":"dev-one","status":"Active"},{"dcosAppId":"dev-two","status":"Paused"},{"dcosAppId":"dev-three","status":"Active"}]}`)
+	})
+
+	cmd := newBulkCommand(t, "-d", "dev-*", "--concurrency", "2")
+	var running, peak int32
+	action := func(info objects.CMClusterInfo) objects.BulkResult {
+		now := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			old := atomic.LoadInt32(&peak)
+			if now <= old || atomic.CompareAndSwapInt32(&peak, old, now) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if !info.Active() {
+			return skipResult(info, "the workspace is not listed as Active")
+		}
+		return actionResult(cmd, info, objects.ActionStatus{Success: info.DcosAppId != "dev-three", Process: "pause", Error: "busy"}, nil, workspaceIn(objects.StatusPaused))
+	}
+
+	selection, _ := newWorkspaceSelection(cmd)
+	err := runBulk(cmd, selection, action)
+	var status *exitStatus
+	if !errors.As(err, &status) || status.code != exitGeneral {
+		t.Fatalf("expected a failed bulk operation, got: %v", err)
+	}
+	if peak > 2 {
+		t.Fatalf("expected at most 2 workspaces at once, got: %d", peak)
+	}
+
+	selection, _ = newWorkspaceSelection(newBulkCommand(t, "-l", "status=Stopped"))
+	if err := runBulk(cmd, selection, action); err == nil || !strings.Contains(err.Error(), "no workspaces match") {
+		t.Fatalf("expected no workspaces to match, got: %v", err)
+	}
+}
+
+func TestBulkDeleteConfirmation(t *testing.T) {
+	withServer(t, "v0.1.7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"clusters":[{"dcosAppId":"dev-one","status":"Active"},{"dcosAppId":"dev-two","status":"Deleted","deletedAt":"2021-03-01"}]}`)
+	})
+	savedPrompt := confirmPrompt
+	defer func() { confirmPrompt = savedPrompt }()
+
+	var prompts, actions int32
+	action := func(info objects.CMClusterInfo) objects.BulkResult {
+		atomic.AddInt32(&actions, 1)
+		return objects.BulkResult{Database: info.DcosAppId, Result: objects.ResultSucceeded}
+	}
+
+	confirmPrompt = func(message string) (bool, error) {
+		prompts++
+		if !strings.Contains(message, "2 workspaces") {
+			t.Errorf("expected the number of workspaces in the question, got: %s", message)
+		}
+		return false, nil
+	}
+	cmd := newBulkCommand(t, "-d", "dev-*")
+	addConfirmFlag(cmd)
+	selection, _ := newWorkspaceSelection(cmd)
+	if err := runBulk(cmd, selection, action); err == nil || !strings.Contains(err.Error(), "cancelled") {
+		t.Fatalf("expected the operation to be cancelled, got: %v", err)
+	}
+	if actions != 0 {
+		t.Fatalf("expected no workspace to change, got: %d", actions)
+	}
+
+	cmd = newBulkCommand(t, "-d", "dev-*")
+	addConfirmFlag(cmd)
+	cmd.Flags().Set("yes", "true")
+	if err := runBulk(cmd, selection, action); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if prompts != 1 || actions != 2 {
+		t.Fatalf("expected --yes to skip the question, got %d prompts and %d actions", prompts, actions)
+	}
+}
+
+func TestDeleteWorkspaceSkipsDeleted(t *testing.T) {
+	withServer(t, "v0.1.7", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("expected no request, got: %s %s", r.Method, r.URL)
+	})
+	result := deleteWorkspace(newBulkCommand(t), objects.CMClusterInfo{DcosAppId: "dev-two", DeletedAt: "2021-03-01"})
+	if result.Result != objects.ResultSkipped {
+		t.Fatalf("expected the deleted workspace to be skipped, got: %+v", result)
+	}
+}
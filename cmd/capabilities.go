@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/client"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+// featureAnnotation - the annotation holding the feature of the API server a
+// command needs, see declareFeature
+const featureAnnotation = "splicectl/feature"
+
+// responseDisplay - prints one version of the response of a feature
+type responseDisplay func(in string) error
+
+// responseDisplays - the displays of each feature, the display of response
+// version 1 first
+var responseDisplays = map[string][]responseDisplay{}
+
+// negotiated - the capabilities of the API server, asked for once per run
+var negotiated *objects.Capabilities
+
+// declareFeature - cmd needs feature of the API server, it is checked before
+// the command runs.  displays print the response versions of the feature,
+// oldest first, see displayFeature.
+func declareFeature(cmd *cobra.Command, feature string, displays ...responseDisplay) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[featureAnnotation] = feature
+	responseDisplays[feature] = displays
+}
+
+// serverCapabilities - the capabilities of the API server.  Servers without
+// the capabilities endpoint get the static table of their version, see
+// objects.StaticCapabilities.
+func serverCapabilities() objects.Capabilities {
+	if negotiated != nil {
+		return *negotiated
+	}
+	capabilities := objects.StaticCapabilities(versionDetail.VersionInfo.Server.SemVer)
+	if apiClient != nil && len(apiServer) > 0 {
+		remote, err := apiClient.Capabilities()
+		var apiErr *client.APIError
+		switch {
+		case err == nil:
+			capabilities = remote
+		case errors.As(err, &apiErr) && (apiErr.IsNotFound() || apiErr.StatusCode == http.StatusNotImplemented):
+			logrus.Debug("The API server has no capabilities endpoint, using the capabilities of its version")
+		default:
+			logrus.WithError(err).Debug("Could not read the API server capabilities, using the capabilities of its version")
+		}
+	}
+	negotiated = &capabilities
+	return capabilities
+}
+
+// requireFeature - an error when the API server doesn't support feature
+func requireFeature(feature string) error {
+	capabilities := serverCapabilities()
+	if capabilities.Supports(feature) {
+		return nil
+	}
+	if capabilities.Source == objects.CapabilitiesStatic {
+		// the error names the server version the feature needs
+		if _, _, err := versionDetail.RequirementMet(feature); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf("the API server, version %s, does not support %s", versionDetail.VersionInfo.Server.SemVer, feature)
+}
+
+// displayFeature - print the response in, with the display of the response
+// version the API server returns for the feature of cmd
+func displayFeature(cmd *cobra.Command, in string) error {
+	feature := cmd.Annotations[featureAnnotation]
+	displays := responseDisplays[feature]
+	if len(displays) == 0 {
+		return fmt.Errorf("%s has no response displays", feature)
+	}
+	capabilities := serverCapabilities()
+	version := capabilities.ResponseVersion(feature)
+	if version < 1 {
+		return fmt.Errorf("the API server does not support %s", feature)
+	}
+	if version > len(displays) {
+		return fmt.Errorf("the API server returns version %d of the %s response, this splicectl understands up to version %d, please upgrade splicectl", version, feature, len(displays))
+	}
+	return displays[version-1](in)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/client"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+func withServer(t *testing.T, semVer string, handler http.HandlerFunc) {
+	server := httptest.NewServer(handler)
+	savedClient, savedServer, savedVersion := apiClient, apiServer, versionDetail
+	t.Cleanup(func() {
+		server.Close()
+		apiClient, apiServer, versionDetail = savedClient, savedServer, savedVersion
+		negotiated = nil
+	})
+	apiServer = server.URL
+	apiClient = client.New(server.URL, "", nil)
+	versionDetail = objects.Version{}
+	versionDetail.VersionInfo.Server.SemVer = semVer
+	negotiated = nil
+}
+
+func TestServerCapabilities(t *testing.T) {
+	withServer(t, "v0.0.16", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"features":{"get_default-cr":3}}`)
+	})
+	capabilities := serverCapabilities()
+	if capabilities.Source != objects.CapabilitiesServer || capabilities.ResponseVersion("get_default-cr") != 3 {
+		t.Fatalf("expected the capabilities of the server, got: %+v", capabilities)
+	}
+	if err := requireFeature("pause"); err == nil || !strings.Contains(err.Error(), "does not support pause") {
+		t.Fatalf("expected pause to be unsupported, got: %v", err)
+	}
+}
+
+func TestServerCapabilitiesFallback(t *testing.T) {
+	withServer(t, "v0.0.16", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	capabilities := serverCapabilities()
+	if capabilities.Source != objects.CapabilitiesStatic || capabilities.ResponseVersion("get_default-cr") != 1 {
+		t.Fatalf("expected the static capabilities of v0.0.16, got: %+v", capabilities)
+	}
+	if err := requireFeature("get_image-tag"); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := requireFeature("pause"); err == nil || !strings.Contains(err.Error(), "v0.1.7 or higher") {
+		t.Fatalf("expected the minimum server version in the error, got: %v", err)
+	}
+}
+
+func TestDisplayFeature(t *testing.T) {
+	var displayed []string
+	display := func(name string) responseDisplay {
+		return func(in string) error {
+			displayed = append(displayed, name+":"+in)
+			return nil
+		}
+	}
+	cmd := &cobra.Command{Use: "test"}
+	declareFeature(cmd, "test_feature", display("v1"), display("v2"))
+	defer delete(responseDisplays, "test_feature")
+
+	tests := []struct {
+		version int
+		want    string
+		wantErr string
+	}{
+		{1, "v1:out", ""},
+		{2, "v2:out", ""},
+		{3, "", "please upgrade splicectl"},
+		{0, "", "does not support test_feature"},
+	}
+	defer func() { negotiated = nil }()
+	for _, tt := range tests {
+		displayed = nil
+		negotiated = &objects.Capabilities{Features: map[string]int{"test_feature": tt.version}}
+		err := displayFeature(cmd, "out")
+		if len(tt.wantErr) > 0 {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("version %d: expected an error containing %q, got: %v", tt.version, tt.wantErr, err)
+			}
+			continue
+		}
+		if err != nil || len(displayed) != 1 || displayed[0] != tt.want {
+			t.Fatalf("version %d: expected %s, got: %v %v", tt.version, tt.want, displayed, err)
+		}
+	}
+}
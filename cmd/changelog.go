@@ -2,10 +2,10 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
@@ -15,24 +15,28 @@ var changelogCmd = &cobra.Command{
 	Long: `EXAMPLES
 	splicectl changelog
 `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		// TODO: output the changelog var...
-		_, semVerNum := ClientSemVer()
+		_, semVerNum, err := ClientSemVer()
+		if err != nil {
+			return err
+		}
 		url := fmt.Sprintf("https://api.github.com/repos/splicemachine/splicectl/releases/tags/%s", semVerNum)
 		client := resty.New()
 		resp, err := client.R().Get(url)
 		if err != nil {
-			logrus.WithError(err).Fatal("request could not be completed due to error, changelog is retrieved through api call to Github that requires internet access")
+			return withMessage(err, "request could not be completed due to error, changelog is retrieved through api call to Github that requires internet access")
 		}
 		jsonMap := make(map[string]interface{})
 		if err := json.Unmarshal(resp.Body(), &jsonMap); err != nil {
-			logrus.WithError(err).Fatal("could not get changelog, recieved error while parsing gh api response")
+			return withMessage(err, "could not get changelog, recieved error while parsing gh api response")
 		}
 		if _, ok := jsonMap["body"]; !ok {
-			logrus.Fatal("an unexpected response was returned from the Github api, the changelog cannot be found")
+			return errors.New("an unexpected response was returned from the Github api, the changelog cannot be found")
 		}
 		// using fmt here instead of logrus/log in order to get rid of leading [INFO] or similar header
 		fmt.Println(jsonMap["body"])
+		return nil
 	},
 }
 
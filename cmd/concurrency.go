@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/client"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+// storedVersions - the vault versions of the resource the manifest describes
+func storedVersions(m objects.Manifest) (objects.VaultVersionList, error) {
+	var versions objects.VaultVersionList
+	var err error
+	switch m.Kind {
+	case objects.KindDefaultCR:
+		if err := requireFeature("versions_default-cr"); err != nil {
+			return versions, err
+		}
+		versions, err = apiClient.DefaultCRVersions()
+	case objects.KindDatabaseCR:
+		if err := requireFeature("versions_database-cr"); err != nil {
+			return versions, err
+		}
+		versions, err = apiClient.DatabaseCRVersions(m.Metadata.DatabaseName)
+	case objects.KindSystemSettings:
+		if err := requireFeature("versions_system-settings"); err != nil {
+			return versions, err
+		}
+		versions, err = apiClient.SystemSettingsVersions()
+	case objects.KindCMSettings:
+		if err := requireFeature("versions_cm-settings"); err != nil {
+			return versions, err
+		}
+		versions, err = apiClient.CMSettingsVersions(strings.ToLower(m.Metadata.Component))
+	case objects.KindVaultKey:
+		if err := requireFeature("versions_vault-key"); err != nil {
+			return versions, err
+		}
+		versions, err = apiClient.VaultKeyVersions(strings.TrimPrefix(m.Metadata.KeyPath, "secrets/"))
+	default:
+		return versions, fmt.Errorf("%s is not versioned", m.Kind)
+	}
+	return versions, err
+}
+
+// writeClient - the client to write the manifest with, when the manifest
+// names an expected version the stored version is checked first and the
+// server is asked for a check-and-set write.
+func writeClient(m objects.Manifest) (*client.Client, error) {
+	if m.Metadata.ExpectedVersion == 0 {
+		return apiClient, nil
+	}
+	versions, err := storedVersions(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.CheckVersion(versions, m.Metadata.ExpectedVersion); err != nil {
+		return nil, err
+	}
+	return apiClient.WithExpectedVersion(m.Metadata.ExpectedVersion), nil
+}
+
+// expectVersion - handle --expected-version for the single resource
+// commands, the client to write with, which makes the write with
+// check-and-set when the flag is given.
+func expectVersion(cmd *cobra.Command, m objects.Manifest) (*client.Client, error) {
+	expected, _ := cmd.Flags().GetInt("expected-version")
+	if expected == 0 {
+		return apiClient, nil
+	}
+	m.Metadata.ExpectedVersion = expected
+	writer, err := writeClient(m)
+	if err != nil {
+		return nil, withMessage(err, fmt.Sprintf("Refusing to write %s", manifestTitle(m)))
+	}
+	return writer, nil
+}
+
+// addExpectedVersionFlag - add --expected-version to an apply or rollback command
+func addExpectedVersionFlag(cmd *cobra.Command) {
+	cmd.Flags().Int("expected-version", 0, "Only write when the latest vault version is N, see splicectl versions")
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+func TestExpectVersion(t *testing.T) {
+	withServer(t, "v0.1.7", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/capabilities") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"3":{"created_time":"a"}}`)
+	})
+	saved := apiClient
+
+	cmd := &cobra.Command{Use: "test"}
+	addExpectedVersionFlag(cmd)
+	writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindDefaultCR})
+	if err != nil || writer != apiClient {
+		t.Fatalf("expected the shared client without --expected-version, got: %v", err)
+	}
+
+	cmd.Flags().Set("expected-version", "3")
+	writer, err = expectVersion(cmd, objects.Manifest{Kind: objects.KindDefaultCR})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if writer == apiClient || apiClient != saved {
+		t.Fatalf("expected a check-and-set client that leaves the shared client alone")
+	}
+
+	cmd.Flags().Set("expected-version", "2")
+	if _, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindDefaultCR}); err == nil || exitCode(err) != exitConflict {
+		t.Fatalf("expected a version conflict, got: %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage the named contexts in the splicectl config file",
+	Long: `EXAMPLES
+	splicectl config set-context prod --server-uri https://splicectl-api.prod.example.com --kube-context gke_prod
+	splicectl config set-context dev --cacert ~/certs/dev-ca.pem
+	splicectl config use-context prod
+	splicectl config get-contexts
+	splicectl --context dev list workspace
+
+	A context holds the API server, CA bundle, Kubernetes context and session
+	for a cluster.  The context named by --context, or the current context,
+	is used by every command, an empty server is discovered from the
+	splicectl-api ingress of the kube context.  Without a context splicectl
+	uses the current context of the kubeconfig.
+	`,
+	// The config commands only work with the config file, they don't need
+	// the API server or a session.
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		commandName = topCommand(cmd)
+		if err := initConfig(); err != nil {
+			return err
+		}
+		return validateOutputFormat()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.Help()
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+}
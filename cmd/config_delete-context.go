@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var configDeleteContextCmd = &cobra.Command{
+	Use:   "delete-context <name>",
+	Short: "Delete a context from the splicectl config file",
+	Long: `EXAMPLES
+	splicectl config delete-context dev
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		contextList, err := loadContexts()
+		if err != nil {
+			return withMessage(err, "Error reading the contexts")
+		}
+		if !contextList.Delete(args[0]) {
+			return fmt.Errorf("context %s is not defined, see splicectl config get-contexts", args[0])
+		}
+		if err := saveContexts(contextList); err != nil {
+			return withMessage(err, "Failed to write config")
+		}
+		fmt.Printf("Deleted context %s\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configDeleteContextCmd)
+}
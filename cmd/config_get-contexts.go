@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var configGetContextsCmd = &cobra.Command{
+	Use:   "get-contexts",
+	Short: "List the contexts in the splicectl config file, the current context is marked with a *",
+	Long: `EXAMPLES
+	splicectl config get-contexts
+	splicectl config get-contexts -o yaml
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		contextList, err := loadContexts()
+		if err != nil {
+			return withMessage(err, "Error reading the contexts")
+		}
+		return printObject(&contextList, "table")
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configGetContextsCmd)
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	homedir "github.com/mitchellh/go-homedir"
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var configSetContextCmd = &cobra.Command{
+	Use:   "set-context <name>",
+	Short: "Create a context, or update the given fields of an existing context",
+	Long: `EXAMPLES
+	splicectl config set-context prod --server-uri https://splicectl-api.prod.example.com
+	splicectl config set-context prod --cacert ~/certs/prod-ca.pem --kube-context gke_prod
+	splicectl config set-context staging --kubeconfig ~/.kube/staging --splice-namespace splice-staging
+	splicectl config set-context prod --server-uri ""
+
+	The global --server-uri, --cacert, --kubeconfig, --kube-context and
+	--splice-namespace flags set the matching field of the context, an empty
+	value clears the field.  Only the flags given are changed.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		contextList, err := loadContexts()
+		if err != nil {
+			return withMessage(err, "Error reading the contexts")
+		}
+		context := objects.Context{Name: args[0]}
+		if existing := contextList.Find(args[0]); existing != nil {
+			context = *existing
+		}
+
+		if cmd.Flags().Changed("server-uri") {
+			context.Server = serverURI
+		}
+		if cmd.Flags().Changed("cacert") {
+			context.CACert = ""
+			if len(caCert) > 0 {
+				if context.CACert, err = absolutePath(caCert); err != nil {
+					return withMessage(err, "Couldn't read the ca-file, please check the path")
+				}
+			}
+		}
+		if cmd.Flags().Changed("kubeconfig") {
+			context.Kubeconfig = ""
+			if len(kubeconfigPath) > 0 {
+				if context.Kubeconfig, err = absolutePath(kubeconfigPath); err != nil {
+					return withMessage(err, "Couldn't read the kubeconfig, please check the path")
+				}
+			}
+		}
+		if cmd.Flags().Changed("kube-context") {
+			context.KubeContext = kubeContext
+		}
+		if cmd.Flags().Changed("splice-namespace") {
+			context.SpliceNamespace = spliceNamespace
+		}
+
+		contextList.Set(context)
+		if err := saveContexts(contextList); err != nil {
+			return withMessage(err, "Failed to write config")
+		}
+		fmt.Printf("Context %s saved\n", context.Name)
+		return nil
+	},
+}
+
+// absolutePath - the absolute path of an existing file, ~ is expanded
+func absolutePath(path string) (string, error) {
+	expanded, err := homedir.Expand(path)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(expanded); err != nil {
+		return "", err
+	}
+	return filepath.Abs(expanded)
+}
+
+func init() {
+	configCmd.AddCommand(configSetContextCmd)
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var configUseContextCmd = &cobra.Command{
+	Use:   "use-context <name>",
+	Short: "Set the current context in the splicectl config file",
+	Long: `EXAMPLES
+	splicectl config use-context prod
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		contextList, err := loadContexts()
+		if err != nil {
+			return withMessage(err, "Error reading the contexts")
+		}
+		if contextList.Find(args[0]) == nil {
+			return fmt.Errorf("context %s is not defined, see splicectl config get-contexts", args[0])
+		}
+		contextList.Current = args[0]
+		if err := saveContexts(contextList); err != nil {
+			return withMessage(err, "Failed to write config")
+		}
+		fmt.Printf("Switched to context %s\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configUseContextCmd)
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+// loadContexts - read the named contexts from the config file
+func loadContexts() (objects.ContextList, error) {
+	contextList := objects.ContextList{
+		Current: viper.GetString("current-context"),
+	}
+	if err := viper.UnmarshalKey("contexts", &contextList.Contexts); err != nil {
+		return contextList, fmt.Errorf("could not read the contexts from the config file: %v", err)
+	}
+	return contextList, nil
+}
+
+// saveContexts - write the named contexts to the config file
+func saveContexts(contextList objects.ContextList) error {
+	viper.Set("current-context", contextList.Current)
+	viper.Set("contexts", contextList.Contexts)
+	return viper.WriteConfig()
+}
+
+// selectContext - the context named by --context, or the current context of
+// the config file, nil when neither is set.
+func selectContext() (*objects.Context, error) {
+	contextList, err := loadContexts()
+	if err != nil {
+		return nil, err
+	}
+	name := contextName
+	if len(name) == 0 {
+		name = contextList.Current
+	}
+	if len(name) == 0 {
+		return nil, nil
+	}
+	context := contextList.Find(name)
+	if context == nil {
+		return nil, fmt.Errorf("context %q is not defined, see splicectl config get-contexts", name)
+	}
+	return context, nil
+}
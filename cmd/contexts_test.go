@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+func TestContextsRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "splicectl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "config.yml")
+	if err := ioutil.WriteFile(configFile, []byte("default-session_id: legacy\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	viper.Reset()
+	defer viper.Reset()
+	viper.SetConfigFile(configFile)
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	contextList := objects.ContextList{Current: "Prod"}
+	contextList.Set(objects.Context{Name: "Prod", Server: "https://prod.example.com", KubeContext: "gke_prod"})
+	contextList.Set(objects.Context{Name: "dev", Server: "https://dev.example.com"})
+	if err := saveContexts(contextList); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	viper.Reset()
+	viper.SetConfigFile(configFile)
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if viper.GetString("default-session_id") != "legacy" {
+		t.Fatalf("expected the other keys to be kept")
+	}
+
+	contextName = ""
+	context, err := selectContext()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if context == nil || context.Name != "Prod" || context.KubeContext != "gke_prod" {
+		t.Fatalf("expected the current context Prod, got: %+v", context)
+	}
+
+	contextName = "dev"
+	defer func() { contextName = "" }()
+	if context, _ = selectContext(); context == nil || context.Server != "https://dev.example.com" {
+		t.Fatalf("expected --context to select dev, got: %+v", context)
+	}
+
+	contextName = "missing"
+	if _, err := selectContext(); err == nil {
+		t.Fatalf("expected an error for an undefined context")
+	}
+}
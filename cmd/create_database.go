@@ -1,16 +1,11 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"os"
 	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
@@ -18,16 +13,6 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// AuthSuccess - Varibles to extract on a resty auth success
-type AuthSuccess struct {
-	/* variables */
-}
-
-// AuthError - Variables to extract on a resty auth error
-type AuthError struct {
-	/* variables */
-}
-
 var createDatabaseCmd = &cobra.Command{
 	Use: "workspace",
 	// splice-database used to be the command name, it is only being kept as an alias for back-compat as it does not match the naming system for other database/workspace commands
@@ -42,6 +27,7 @@ var createDatabaseCmd = &cobra.Command{
 	splicectl create workspace --skel --account-id <accountid> --cloud-provider <aws|az|gcp|op|none> > ~/tmp/splicedb-create.yaml
 	# edit the ~/tmp/splicedb-create.yaml
 	splicectl create workspace --file ~/tmp/splicedb-create.yaml
+	splicectl create workspace --file ~/tmp/splicedb-create.yaml --wait --timeout 30m
 	
 	Note: --database-name and -d are the preferred way to supply the database name.
 	However, --database and --workspace can also be used as well. In the event that
@@ -49,12 +35,7 @@ var createDatabaseCmd = &cobra.Command{
 	and workspace is preferred over database. The most preferred option that is
 	supplied will be used and a message will be displayed letting you know which
 	option was chosen if more than one were supplied.`,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("create_database")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		// Look for --file first, load that into the structure, then read each
 		// parameters and override the values loaded from the input file
 		skel, _ := cmd.Flags().GetBool("skel")
@@ -68,45 +49,42 @@ var createDatabaseCmd = &cobra.Command{
 
 			jsonBytes, cerr := common.WantJSON(fileBytes)
 			if cerr != nil {
-				logrus.Fatal("The input data MUST be in either JSON or YAML format")
+				return withMessage(cerr, "The input data MUST be in either JSON or YAML format")
 			}
 			if len(jsonBytes) > 0 {
 				marshErr := json.Unmarshal(jsonBytes, &dbReq)
 				if marshErr != nil {
-					logrus.Fatal("Could not unmarshall data", marshErr)
+					return withMessage(marshErr, "Could not unmarshall data")
 				}
 			}
 			fileProvided = true
 		}
 
-		populateRequest(cmd, &dbReq, fileProvided)
+		if err := populateRequest(cmd, &dbReq, fileProvided); err != nil {
+			return err
+		}
 
 		if skel {
-			generateSkel(&dbReq)
-			os.Exit(0)
+			return generateSkel(&dbReq)
 		}
 
-		out, err := createSpliceDatabase(&dbReq, false)
+		out, err := createSpliceDatabase(&dbReq)
 		if err != nil {
-			logrus.WithError(err).Error("Error Generating Default CR Info")
+			return withMessage(err, "Error Generating Default CR Info")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.1.7"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayCreateSpliceDatabaseV1(out)
-			}
+		if err := displayFeature(cmd, out); err != nil {
+			return err
 		}
+		return waitForWorkspace(cmd, dbReq.Name, workspaceIn(objects.StatusActive))
 	},
 }
 
-func displayCreateSpliceDatabaseV1(in string) {
-	fmt.Println(in)
-	os.Exit(0)
+func displayCreateSpliceDatabaseV1(in string) error {
+	return displayActionStatus(in)
 }
 
-func populateRequest(cmd *cobra.Command, req *objects.DatabaseRequest, fileData bool) {
+func populateRequest(cmd *cobra.Command, req *objects.DatabaseRequest, fileData bool) error {
 
 	requiredList := []string{}
 
@@ -210,75 +188,26 @@ func populateRequest(cmd *cobra.Command, req *objects.DatabaseRequest, fileData
 		for _, v := range requiredList {
 			logrus.Warn(fmt.Sprintf("Required parameter not provided: %s", v))
 		}
-		os.Exit(1)
+		return fmt.Errorf("required parameters not provided: %s", strings.Join(requiredList, ", "))
 	}
+	return nil
 }
 
-func generateSkel(dbReq *objects.DatabaseRequest) {
-
-	if !formatOverridden {
-		outputFormat = "yaml"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json", "gron":
-		dbReq.ToJSON()
-	case "yaml", "text", "table":
-		dbReq.ToYAML()
-	}
-
+func generateSkel(dbReq *objects.DatabaseRequest) error {
+	return printObject(dbReq, "yaml")
 }
-func createSpliceDatabase(dbReq *objects.DatabaseRequest, outputonly bool) (string, error) {
-
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
-	}
-
-	uri := "splicectl/v1/splicedb/splicedatabase"
-
-	var resp *resty.Response
-	var resperr error
-
-	if outputonly {
-		resp, resperr = restClient.R().
-			SetHeader("Content-Type", "application/json").
-			SetHeader("Accept", "application/json").
-			SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-			SetHeader("X-Token-Session", authClient.GetSessionID()).
-			Get(fmt.Sprintf("%s/%s", apiServer, uri))
-	} else {
-
-		reqJSON, enverr := json.MarshalIndent(dbReq, "", "  ")
-		if enverr != nil {
-			logrus.WithError(enverr).Error("Error extracting json")
-			return "", enverr
-		}
-		resp, resperr = restClient.R().
-			SetHeader("Content-Type", "application/json").
-			SetHeader("Accept", "application/json").
-			SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-			SetHeader("X-Token-Session", authClient.GetSessionID()).
-			SetBody(reqJSON).
-			Post(fmt.Sprintf("%s/%s", apiServer, uri))
-	}
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Default CR Info")
-		return "", resperr
+func createSpliceDatabase(dbReq *objects.DatabaseRequest) (string, error) {
+	out, err := apiClient.CreateDatabaseRaw(dbReq)
+	if err != nil {
+		return "", err
 	}
 
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	createCmd.AddCommand(createDatabaseCmd)
+	declareFeature(createDatabaseCmd, "create_database", displayCreateSpliceDatabaseV1)
 
 	createDatabaseCmd.Flags().BoolP("skel", "s", false, "Generate a skeleton values file for submission")
 	createDatabaseCmd.Flags().StringP("file", "f", "", "Specify the input file")
@@ -306,5 +235,6 @@ func init() {
 	createDatabaseCmd.Flags().Int("notebook-executors", 2, "Specify the max number Spark Executors per notebook (default=2)")
 	createDatabaseCmd.Flags().Int("notebook-total-users", 10, "Specify the max notebook users (default=10)")
 	createDatabaseCmd.Flags().Int("notebooks-per-user", 2, "Specify the max number of notebooks per user (default=2)")
+	addWaitFlags(createDatabaseCmd)
 
 }
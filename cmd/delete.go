@@ -1,18 +1,11 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
-	"fmt"
-	"os"
+	"errors"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/splicemachine/splicectl/cmd/objects"
-	"github.com/splicemachine/splicectl/common"
 )
 
 var deleteCmd = &cobra.Command{
@@ -22,117 +15,119 @@ var deleteCmd = &cobra.Command{
 	Long: `EXAMPLES
 	splicectl list workspace
 	splicectl delete --database-name <database> --delete
+	splicectl delete --database-name <database> --delete --wait
+	splicectl delete --database-name 'dev-*' --delete --yes
 
 	* The '--delete' is required as a validation for the deletion request
+	* Deleting several workspaces lists them and asks for a confirmation,
+	  '--yes' skips the question
 	  
-	Note: --database-name and -d are the preferred way to supply the database name.
-	However, --database and --workspace can also be used as well. In the event that
+	Note: --database-name and -d are the preferred way to supply the database name,
+	repeat them or use a glob pattern to select several workspaces. However,
+	--database and --workspace can also be used to name one. In the event that
 	more than one of them is supplied database-name and d are preferred over all
 	and workspace is preferred over database. The most preferred option that is
 	supplied will be used and a message will be displayed letting you know which
 	option was chosen if more than one were supplied. `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var dberr error
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("delete")
-
 		verifyDelete, _ := cmd.Flags().GetBool("delete")
-		databaseName := common.DatabaseName(cmd)
+		selection, err := newWorkspaceSelection(cmd)
+		if err != nil {
+			return err
+		}
+		if selection.bulk() {
+			if !verifyDelete {
+				return errors.New("You MUST specify --delete on the commandline to validate the deletion")
+			}
+			return runBulk(cmd, selection, func(info objects.CMClusterInfo) objects.BulkResult {
+				return deleteWorkspace(cmd, info)
+			})
+		}
+
+		databaseName := selection.name()
 		if len(databaseName) == 0 {
 			databaseName, dberr = promptForDatabaseName()
 			if dberr != nil {
-				logrus.Fatal("Could not get a list of workspaces", dberr)
+				return withMessage(dberr, "Could not get a list of workspaces")
 			}
 		}
 		if verifyDelete {
-			clusterID := getMatchingClusterID(databaseName)
+			clusterID, err := getMatchingClusterID(databaseName)
+			if err != nil {
+				return err
+			}
 			if len(clusterID) > 0 {
 				out, err := deleteDatabase(clusterID)
 				if err != nil {
-					logrus.Warn("Deleting workspace failed.")
+					return withMessage(err, "Deleting workspace failed")
 				}
-				if semverV1, err := semver.ParseRange(">=0.1.7"); err != nil {
-					logrus.Fatal("Failed to parse SemVer")
-				} else {
-					if semverV1(sv) {
-						displayDeleteV1(out)
-					}
+				if err := displayFeature(cmd, out); err != nil {
+					return err
 				}
+				return waitForWorkspace(cmd, databaseName, workspaceGone())
 			} else {
-				logrus.Fatal("Unable to determine ClusterId from workspace Name")
+				return errors.New("Unable to determine ClusterId from workspace Name")
 			}
 		} else {
-			logrus.Fatal("You MUST specify --delete on the commandline to validate the deletion")
+			return errors.New("You MUST specify --delete on the commandline to validate the deletion")
 		}
 	},
 }
 
-func displayDeleteV1(in string) {
-	fmt.Println(in)
-	os.Exit(0)
+// deleteWorkspace - delete one workspace of a bulk delete, the workspaces
+// already deleted are skipped
+func deleteWorkspace(cmd *cobra.Command, info objects.CMClusterInfo) objects.BulkResult {
+	if len(info.DeletedAt) > 0 {
+		return skipResult(info, "the workspace is already deleted")
+	}
+	status, err := apiClient.DeleteDatabase(info.ClusterId)
+	return actionResult(cmd, info, status, err, workspaceGone())
+}
+
+func displayDeleteV1(in string) error {
+	return displayActionStatus(in)
 }
 
-func getMatchingClusterID(db string) string {
+func getMatchingClusterID(db string) (string, error) {
 	dbJSON, err := getDatabaseList()
 	if err != nil {
-		logrus.WithError(err).Fatal("Error retreiving ClusterId list")
+		return "", withMessage(err, "Error retreiving ClusterId list")
 	}
 	var dbList objects.DatabaseList
 
 	marshErr := json.Unmarshal([]byte(dbJSON), &dbList)
 	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall workspace list for ClusterId", marshErr)
+		return "", withMessage(marshErr, "Could not unmarshall workspace list for ClusterId")
 	}
 
 	for _, v := range dbList.Clusters {
 		if v.DcosAppId == db {
-			return v.ClusterId
+			return v.ClusterId, nil
 		}
 	}
 
-	return ""
+	return "", nil
 }
 
 func deleteDatabase(cid string) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
-	}
-
-	uri := fmt.Sprintf("splicectl/v1/splicedb/splicedatabasedelete?database-name=%s", cid)
-
-	var resp *resty.Response
-	var resperr error
-
-	resp, resperr = restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Delete(fmt.Sprintf("%s/%s", apiServer, uri))
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Default CR Info")
-		return "", resperr
+	out, err := apiClient.DeleteDatabaseRaw(cid)
+	if err != nil {
+		return "", err
 	}
 
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	rootCmd.AddCommand(deleteCmd)
+	declareFeature(deleteCmd, "delete", displayDeleteV1)
 
-	// add database name and aliases
-	deleteCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
-	deleteCmd.Flags().String("database", "", "Alias for database-name, prefer the use of -d and --database-name.")
-	deleteCmd.Flags().String("workspace", "", "Alias for database-name, prefer the use of -d and --database-name.")
+	// add database name, aliases and the bulk selection
+	addSelectionFlags(deleteCmd)
 
 	deleteCmd.Flags().Bool("delete", false, "Verification parameter to perform the deletion")
+	addWaitFlags(deleteCmd)
+	addConfirmFlag(deleteCmd)
+
 }
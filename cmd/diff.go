@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/common"
+	"github.com/splicemachine/splicectl/diff"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the differences between local files and the configuration stored in the cluster",
+	Long: `EXAMPLES
+	splicectl diff default-cr --file ~/tmp/default-cr.yaml
+	splicectl diff -f ~/cluster-config/
+
+	Nothing is written to the cluster.  The exit code is 0 when there are no
+	differences and 2 when there are, so CI jobs can detect drift.
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filePath, _ := cmd.Flags().GetString("filename")
+		if len(filePath) == 0 {
+			cmd.Help()
+			return nil
+		}
+
+		manifests, err := common.ReadManifests(filePath)
+		if err != nil {
+			return withMessage(err, "Error reading manifests")
+		}
+		return runDiff(manifests)
+	},
+}
+
+// newManifest - build a manifest from the JSON document of one of the kind
+// specific commands, ie: apply default-cr --file
+func newManifest(kind string, metadata objects.ManifestMetadata, jsonBytes []byte, source string) (objects.Manifest, error) {
+	manifest := objects.Manifest{
+		Kind:     kind,
+		Metadata: metadata,
+		Source:   source,
+	}
+	if err := json.Unmarshal(jsonBytes, &manifest.Body); err != nil {
+		return manifest, withMessage(err, "The input data MUST be a JSON or YAML object")
+	}
+	return manifest, nil
+}
+
+// readManifestFile - build a manifest from a JSON or YAML file
+func readManifestFile(kind string, metadata objects.ManifestMetadata, filePath string) (objects.Manifest, error) {
+	fileBytes, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return objects.Manifest{}, withMessage(err, "Could not read the input file")
+	}
+	jsonBytes, cerr := common.WantJSON(fileBytes)
+	if cerr != nil {
+		return objects.Manifest{}, withMessage(cerr, "The input data MUST be in either JSON or YAML format")
+	}
+	return newManifest(kind, metadata, jsonBytes, filePath)
+}
+
+// currentValue - fetch the value stored in the cluster for the resource the
+// manifest describes, using the matching get helper.
+func currentValue(m objects.Manifest) ([]byte, error) {
+	return storedValue(m, 0)
+}
+
+// storedValue - fetch a version of the value stored in the cluster for the
+// resource the manifest describes, version 0 is the latest.
+func storedValue(m objects.Manifest, version int) ([]byte, error) {
+	var out string
+	var err error
+	switch m.Kind {
+	case objects.KindDefaultCR:
+		if err := requireFeature("get_default-cr"); err != nil {
+			return nil, err
+		}
+		out, err = getDefaultCR(version)
+	case objects.KindDatabaseCR:
+		if err := requireFeature("get_database-cr"); err != nil {
+			return nil, err
+		}
+		out, err = getDatabaseCR(m.Metadata.DatabaseName, version)
+	case objects.KindSystemSettings:
+		if err := requireFeature("get_system-settings"); err != nil {
+			return nil, err
+		}
+		out, err = getSystemSettings(version)
+	case objects.KindCMSettings:
+		if err := requireFeature("get_cm-settings"); err != nil {
+			return nil, err
+		}
+		out, err = getCMSettings(strings.ToLower(m.Metadata.Component), version)
+	case objects.KindVaultKey:
+		if err := requireFeature("get_vault-key"); err != nil {
+			return nil, err
+		}
+		out, err = getVaultKeyData(strings.TrimPrefix(m.Metadata.KeyPath, "secrets/"), version)
+	case objects.KindImageTag:
+		if version != 0 {
+			return nil, fmt.Errorf("%s is not versioned", m.Kind)
+		}
+		if err := requireFeature("get_image-tag"); err != nil {
+			return nil, err
+		}
+		out, err = currentImageTag(m.Metadata.Component, m.Metadata.DatabaseName)
+	default:
+		return nil, fmt.Errorf("unknown kind %s", m.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return common.WantJSON([]byte(out))
+}
+
+// currentImageTag - the tag in the workspace CR for the component, in the
+// same {"tag": "..."} shape as an ImageTag manifest.
+func currentImageTag(componentName string, databaseName string) (string, error) {
+	tagList, err := apiClient.ImageTags(componentName, databaseName)
+	if err != nil {
+		return "", err
+	}
+	tag := ""
+	for _, imageTag := range tagList.ImageTags {
+		if strings.EqualFold(imageTag.Component, componentName) || len(tagList.ImageTags) == 1 {
+			tag = imageTag.DatabaseCRImage[strings.LastIndex(imageTag.DatabaseCRImage, ":")+1:]
+		}
+	}
+	out, err := json.Marshal(map[string]string{"tag": tag})
+	return string(out), err
+}
+
+// diffManifest - compare the manifest with the value stored in the cluster
+func diffManifest(m objects.Manifest) ([]diff.Change, error) {
+	current, err := currentValue(m)
+	if err != nil {
+		return nil, err
+	}
+	desired, err := json.Marshal(m.Body)
+	if err != nil {
+		return nil, err
+	}
+	if desired, err = common.WantJSON(desired); err != nil {
+		return nil, err
+	}
+	return diff.CompareJSON(current, desired)
+}
+
+// runDiff - print the differences for each manifest, nothing is written to
+// the cluster.  Fails with exitDifferences when any manifest differs.
+func runDiff(manifests []objects.Manifest) error {
+	found := false
+	for _, m := range manifests {
+		title := manifestTitle(m)
+		changes, err := diffManifest(m)
+		if err != nil {
+			return withMessage(err, fmt.Sprintf("Error comparing %s", title))
+		}
+		if len(changes) == 0 {
+			logrus.Info(fmt.Sprintf("%s (%s) has no differences", title, m.Source))
+			continue
+		}
+
+		found = true
+		fmt.Printf("%s (%s)\n", title, m.Source)
+		if err := diff.Write(os.Stdout, changes); err != nil {
+			return withMessage(err, "Error writing output")
+		}
+	}
+	if found {
+		return &exitStatus{code: exitDifferences}
+	}
+	return nil
+}
+
+// manifestTitle - the kind and name of the resource, ie: DatabaseCR splicedb
+func manifestTitle(m objects.Manifest) string {
+	if name := m.Name(); name != "-" {
+		return fmt.Sprintf("%s %s", m.Kind, name)
+	}
+	return m.Kind
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().StringP("filename", "f", "", "Compare the manifests in a file or directory, - reads stdin")
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var diffCMSettingsCmd = &cobra.Command{
+	Use:   "cm-settings",
+	Short: "Compare a cm-settings file with the cloud manager settings of the cluster",
+	Long: `EXAMPLES
+	splicectl get cm-settings --component ui -o json > ~/tmp/cm-ui.json
+	# edit file
+	splicectl diff cm-settings --component ui --file ~/tmp/cm-ui.json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		component, _ := cmd.Flags().GetString("component")
+		component = strings.ToLower(component)
+		if len(component) == 0 || !strings.Contains("ui api", component) {
+			return fmt.Errorf("--component needs to be 'ui' or 'api'")
+		}
+		filePath, _ := cmd.Flags().GetString("file")
+		manifest, err := readManifestFile(objects.KindCMSettings, objects.ManifestMetadata{Component: component}, filePath)
+		if err != nil {
+			return err
+		}
+		return runDiff([]objects.Manifest{manifest})
+	},
+}
+
+func init() {
+	diffCmd.AddCommand(diffCMSettingsCmd)
+
+	diffCMSettingsCmd.Flags().String("file", "", "Specify the input file")
+	diffCMSettingsCmd.Flags().StringP("component", "c", "", "Specify the component, <ui|api>")
+	diffCMSettingsCmd.MarkFlagRequired("file")
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/common"
+)
+
+var diffDatabaseCRCmd = &cobra.Command{
+	Use:   "database-cr",
+	Short: "Compare a database-cr file with the CR of a workspace",
+	Long: `EXAMPLES
+	splicectl get database-cr --database-name splicedb -o json > ~/tmp/splicedb-cr.json
+	# edit file
+	splicectl diff database-cr --database-name splicedb --file ~/tmp/splicedb-cr.json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var dberr error
+
+		databaseName := common.DatabaseName(cmd)
+		if len(databaseName) == 0 {
+			databaseName, dberr = promptForDatabaseName()
+			if dberr != nil {
+				return withMessage(dberr, "Could not get a list of Databases")
+			}
+		}
+		filePath, _ := cmd.Flags().GetString("file")
+		manifest, err := readManifestFile(objects.KindDatabaseCR, objects.ManifestMetadata{DatabaseName: databaseName}, filePath)
+		if err != nil {
+			return err
+		}
+		return runDiff([]objects.Manifest{manifest})
+	},
+}
+
+func init() {
+	diffCmd.AddCommand(diffDatabaseCRCmd)
+
+	// add database name and aliases
+	diffDatabaseCRCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
+	diffDatabaseCRCmd.Flags().String("database", "", "Alias for database-name, prefer the use of -d and --database-name.")
+	diffDatabaseCRCmd.Flags().String("workspace", "", "Alias for database-name, prefer the use of -d and --database-name.")
+
+	diffDatabaseCRCmd.Flags().StringP("file", "f", "", "Specify the input file")
+	diffDatabaseCRCmd.MarkFlagRequired("file")
+}
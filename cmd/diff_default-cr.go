@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var diffDefaultCRCmd = &cobra.Command{
+	Use:   "default-cr",
+	Short: "Compare a default-cr file with the default-cr of the cluster",
+	Long: `EXAMPLES
+	splicectl get default-cr -o json > ~/tmp/default-cr.json
+	# edit file
+	splicectl diff default-cr --file ~/tmp/default-cr.json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filePath, _ := cmd.Flags().GetString("file")
+		manifest, err := readManifestFile(objects.KindDefaultCR, objects.ManifestMetadata{}, filePath)
+		if err != nil {
+			return err
+		}
+		body, err := json.Marshal(manifest.Body)
+		if err != nil {
+			return err
+		}
+		if _, err := validateDefaultCR(body); err != nil {
+			return withMessage(err, "Error validating Default CR")
+		}
+		return runDiff([]objects.Manifest{manifest})
+	},
+}
+
+func init() {
+	diffCmd.AddCommand(diffDefaultCRCmd)
+
+	diffDefaultCRCmd.Flags().String("file", "", "Specify the input file")
+	diffDefaultCRCmd.MarkFlagRequired("file")
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var diffImageTagCmd = &cobra.Command{
+	Use:   "image-tag",
+	Short: "Compare an image tag with the image tag in the CR of a workspace",
+	Long: `EXAMPLES
+	splicectl diff image-tag --database-name splicedb --component-name hbase --tag master-246
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var dberr error
+
+		componentName, _ := cmd.Flags().GetString("component-name")
+		databaseName, _ := cmd.Flags().GetString("database-name")
+		if len(databaseName) == 0 {
+			databaseName, dberr = promptForDatabaseName()
+			if dberr != nil {
+				return withMessage(dberr, "Could not get a list of Databases")
+			}
+		}
+		tag, _ := cmd.Flags().GetString("tag")
+
+		return runDiff([]objects.Manifest{{
+			Kind:     objects.KindImageTag,
+			Metadata: objects.ManifestMetadata{DatabaseName: databaseName, Component: componentName},
+			Body:     map[string]interface{}{"tag": tag},
+			Source:   "--tag",
+		}})
+	},
+}
+
+func init() {
+	diffCmd.AddCommand(diffImageTagCmd)
+
+	diffImageTagCmd.Flags().StringP("component-name", "c", "", "Specify the component")
+	diffImageTagCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
+	diffImageTagCmd.Flags().StringP("tag", "t", "", "Specify the image tag, ie: master-246")
+
+	diffImageTagCmd.MarkFlagRequired("component-name")
+	diffImageTagCmd.MarkFlagRequired("tag")
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var diffSystemSettingsCmd = &cobra.Command{
+	Use:   "system-settings",
+	Short: "Compare a system-settings file with the system settings of the cluster",
+	Long: `EXAMPLES
+	splicectl get system-settings -o json > ~/tmp/system-settings.json
+	# edit file
+	splicectl diff system-settings --file ~/tmp/system-settings.json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filePath, _ := cmd.Flags().GetString("file")
+		manifest, err := readManifestFile(objects.KindSystemSettings, objects.ManifestMetadata{}, filePath)
+		if err != nil {
+			return err
+		}
+		return runDiff([]objects.Manifest{manifest})
+	},
+}
+
+func init() {
+	diffCmd.AddCommand(diffSystemSettingsCmd)
+
+	diffSystemSettingsCmd.Flags().String("file", "", "Specify the input file")
+	diffSystemSettingsCmd.MarkFlagRequired("file")
+}
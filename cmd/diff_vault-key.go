@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var diffVaultKeyCmd = &cobra.Command{
+	Use:   "vault-key",
+	Short: "Compare a file with the data stored at a key path in vault",
+	Long: `EXAMPLES
+	splicectl get vault-key --keypath services/cloudmanager/config/default/ui -o json > ~/tmp/cm-ui.json
+	# edit file
+	splicectl diff vault-key --keypath services/cloudmanager/config/default/ui --file ~/tmp/cm-ui.json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		keyPath, _ := cmd.Flags().GetString("keypath")
+		filePath, _ := cmd.Flags().GetString("file")
+		manifest, err := readManifestFile(objects.KindVaultKey, objects.ManifestMetadata{KeyPath: keyPath}, filePath)
+		if err != nil {
+			return err
+		}
+		return runDiff([]objects.Manifest{manifest})
+	},
+}
+
+func init() {
+	diffCmd.AddCommand(diffVaultKeyCmd)
+
+	diffVaultKeyCmd.Flags().String("keypath", "", "Specify the vault key path")
+	diffVaultKeyCmd.Flags().String("file", "", "Specify the input file")
+	diffVaultKeyCmd.MarkFlagRequired("keypath")
+	diffVaultKeyCmd.MarkFlagRequired("file")
+}
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/common"
+	"github.com/splicemachine/splicectl/diff"
+	"github.com/splicemachine/splicectl/editor"
+	"sigs.k8s.io/yaml"
+)
+
+var editCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit resources of the Splice Machine Database Cluster in your editor",
+	Long: `EXAMPLES
+	splicectl edit default-cr
+	splicectl edit database-cr -d splicedb
+	EDITOR="code --wait" splicectl edit system-settings
+
+	The resource is opened as YAML in the editor from SPLICECTL_EDITOR or
+	EDITOR, vi when neither is set.  When the file is saved it is validated
+	and applied, creating a new Vault version, only if it was changed.  A file
+	that fails validation is re-opened with the error at the top, saving it
+	unchanged or saving an empty file cancels the edit.  The edit is refused
+	when someone else wrote a new version while the editor was open.
+	`,
+	Run: func(cmd *cobra.Command, args []string) {},
+}
+
+// runEdit - fetch the resource the manifest describes, open it in the editor
+// until it is valid, then apply it when it was changed.
+func runEdit(m objects.Manifest) error {
+	// record the version read so the write fails if it changes meanwhile
+	versions, err := storedVersions(m)
+	if err != nil {
+		return withMessage(err, fmt.Sprintf("Error getting the versions of %s", manifestTitle(m)))
+	}
+	m.Metadata.ExpectedVersion = versions.Latest()
+
+	current, err := currentValue(m)
+	if err != nil {
+		return withMessage(err, fmt.Sprintf("Error getting %s", manifestTitle(m)))
+	}
+	content, err := yaml.JSONToYAML(current)
+	if err != nil {
+		return withMessage(err, "Could not convert the data to YAML")
+	}
+
+	var editErr error
+	for {
+		edited, err := editor.Edit(editBuffer(m, editErr, content), ".yaml")
+		if err != nil {
+			return withMessage(err, "Error running the editor")
+		}
+		edited = stripEditHeader(edited)
+		if len(bytes.TrimSpace(edited)) == 0 {
+			logrus.Info("Edit cancelled, the file was empty")
+			return nil
+		}
+		if editErr != nil && bytes.Equal(edited, content) {
+			return withMessage(editErr, "Edit cancelled, the file was saved without fixing the error")
+		}
+		content = edited
+
+		var jsonBytes []byte
+		if jsonBytes, editErr = parseEdit(&m, edited); editErr != nil {
+			continue
+		}
+		changes, err := diff.CompareJSON(current, jsonBytes)
+		if err != nil {
+			return withMessage(err, "Error comparing the edited data")
+		}
+		if len(changes) == 0 {
+			logrus.Info("Edit cancelled, no changes made")
+			return nil
+		}
+
+		version, err := applyManifest(m)
+		if err != nil {
+			if saved, serr := saveEdit(content); serr == nil {
+				logrus.Info(fmt.Sprintf("A copy of your changes has been stored in %s", saved))
+			}
+			return withMessage(err, fmt.Sprintf("Error applying %s", manifestTitle(m)))
+		}
+		if version.Version == 0 {
+			logrus.Info(fmt.Sprintf("%s applied", manifestTitle(m)))
+			return nil
+		}
+		return printObject(&version, "text")
+	}
+}
+
+// editBuffer - the content opened in the editor, a comment header explains
+// how the edit works and shows the error from the previous attempt.
+func editBuffer(m objects.Manifest, editErr error, content []byte) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Please edit the %s below.  Lines beginning with a '#' at the top\n", manifestTitle(m))
+	buf.WriteString("# of the file will be ignored, and an empty file will cancel the edit.  If\n")
+	buf.WriteString("# an error occurs while saving, this file will be re-opened with the error.\n")
+	if editErr != nil {
+		buf.WriteString("#\n")
+		fmt.Fprintf(&buf, "# error: %s\n", editErr)
+	}
+	buf.WriteString("#\n")
+	buf.Write(content)
+	return buf.Bytes()
+}
+
+// stripEditHeader - remove the comment lines at the top of the file
+func stripEditHeader(content []byte) []byte {
+	for len(content) > 0 && content[0] == '#' {
+		end := bytes.IndexByte(content, '\n')
+		if end < 0 {
+			return nil
+		}
+		content = content[end+1:]
+	}
+	return content
+}
+
+// parseEdit - convert the edited YAML to JSON, validate it and store it as
+// the body of the manifest.
+func parseEdit(m *objects.Manifest, edited []byte) ([]byte, error) {
+	jsonBytes, err := common.WantJSON(edited)
+	if err != nil {
+		return nil, fmt.Errorf("the data is not valid YAML: %v", err)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(jsonBytes, &body); err != nil || body == nil {
+		return nil, errors.New("the data must be a YAML object")
+	}
+	if m.Kind == objects.KindDefaultCR {
+		if _, err := validateDefaultCR(jsonBytes); err != nil {
+			return nil, err
+		}
+	}
+	m.Body = body
+	return jsonBytes, nil
+}
+
+// saveEdit - keep a copy of edits that could not be applied
+func saveEdit(content []byte) (string, error) {
+	file, err := ioutil.TempFile("", "splicectl-edit-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	if _, err := file.Write(content); err != nil {
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+func init() {
+	rootCmd.AddCommand(editCmd)
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var editCMSettingsCmd = &cobra.Command{
+	Use:   "cm-settings",
+	Short: "Edit the cm (cloud manager) settings of the cluster in your editor",
+	Long: `EXAMPLES
+	splicectl edit cm-settings --component ui
+	splicectl edit cm-settings --component api
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		component, _ := cmd.Flags().GetString("component")
+		component = strings.ToLower(component)
+		if len(component) == 0 || !strings.Contains("ui api", component) {
+			return fmt.Errorf("--component needs to be 'ui' or 'api'")
+		}
+		return runEdit(objects.Manifest{
+			Kind:     objects.KindCMSettings,
+			Metadata: objects.ManifestMetadata{Component: component},
+		})
+	},
+}
+
+func init() {
+	editCmd.AddCommand(editCMSettingsCmd)
+
+	editCMSettingsCmd.Flags().StringP("component", "c", "", "Specify the component, <ui|api>")
+}
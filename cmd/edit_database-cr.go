@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/common"
+)
+
+var editDatabaseCRCmd = &cobra.Command{
+	Use:   "database-cr",
+	Short: "Edit the CR of a workspace in your editor, this should only be done on paused databases",
+	Long: `EXAMPLES
+	splicectl list workspace
+	splicectl edit database-cr --database-name splicedb
+
+	Note: --database-name and -d are the preferred way to supply the database name.
+	However, --database and --workspace can also be used as well. In the event that
+	more than one of them is supplied database-name and d are preferred over all
+	and workspace is preferred over database. The most preferred option that is
+	supplied will be used and a message will be displayed letting you know which
+	option was chosen if more than one were supplied.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var dberr error
+
+		databaseName := common.DatabaseName(cmd)
+		if len(databaseName) == 0 {
+			databaseName, dberr = promptForDatabaseName()
+			if dberr != nil {
+				return withMessage(dberr, "Could not get a list of Databases")
+			}
+		}
+		return runEdit(objects.Manifest{
+			Kind:     objects.KindDatabaseCR,
+			Metadata: objects.ManifestMetadata{DatabaseName: databaseName},
+		})
+	},
+}
+
+func init() {
+	editCmd.AddCommand(editDatabaseCRCmd)
+
+	// add database name and aliases
+	editDatabaseCRCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
+	editDatabaseCRCmd.Flags().String("database", "", "Alias for database-name, prefer the use of -d and --database-name.")
+	editDatabaseCRCmd.Flags().String("workspace", "", "Alias for database-name, prefer the use of -d and --database-name.")
+}
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var editDefaultCRCmd = &cobra.Command{
+	Use:   "default-cr",
+	Short: "Edit the default CR of the cluster in your editor",
+	Long: `EXAMPLES
+	splicectl edit default-cr
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runEdit(objects.Manifest{Kind: objects.KindDefaultCR})
+	},
+}
+
+func init() {
+	editCmd.AddCommand(editDefaultCRCmd)
+}
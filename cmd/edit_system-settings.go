@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var editSystemSettingsCmd = &cobra.Command{
+	Use:   "system-settings",
+	Short: "Edit the system settings of the cluster in your editor",
+	Long: `EXAMPLES
+	splicectl edit system-settings
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runEdit(objects.Manifest{Kind: objects.KindSystemSettings})
+	},
+}
+
+func init() {
+	editCmd.AddCommand(editSystemSettingsCmd)
+}
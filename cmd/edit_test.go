@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+func TestEditBufferRoundTrip(t *testing.T) {
+	content := []byte("# a comment kept by the user\ndata:\n  key: value\n")
+	m := objects.Manifest{Kind: objects.KindDefaultCR}
+
+	buf := editBuffer(m, errors.New("something went wrong"), content)
+	if !strings.Contains(string(buf), "# error: something went wrong\n") {
+		t.Fatalf("expected the error in the header, got: %s", buf)
+	}
+	if stripped := stripEditHeader(buf); string(stripped) != "data:\n  key: value\n" {
+		t.Fatalf("expected the header to be removed, got: %q", stripped)
+	}
+	if stripped := stripEditHeader([]byte("# only a comment")); len(stripped) != 0 {
+		t.Fatalf("expected nothing to be left, got: %q", stripped)
+	}
+}
+
+func TestParseEdit(t *testing.T) {
+	m := objects.Manifest{Kind: objects.KindDefaultCR}
+	if _, err := parseEdit(&m, []byte("data:\n  key: value\n")); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if _, ok := m.Body["data"]; !ok {
+		t.Fatalf("expected the body to be set, got: %v", m.Body)
+	}
+
+	for _, edited := range []string{"key: value\n", "data: [a\n", "- a list\n"} {
+		if _, err := parseEdit(&objects.Manifest{Kind: objects.KindDefaultCR}, []byte(edited)); err == nil {
+			t.Errorf("expected an error for: %s", edited)
+		}
+	}
+	if _, err := parseEdit(&objects.Manifest{Kind: objects.KindSystemSettings}, []byte("key: value\n")); err != nil {
+		t.Errorf("expected no error for system settings, got: %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+var editVaultKeyCmd = &cobra.Command{
+	Use:   "vault-key",
+	Short: "Edit a specific vault key in your editor",
+	Long: `EXAMPLES
+	splicectl edit vault-key --keypath services/cloudmanager/config/default/ui
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		keyPath, _ := cmd.Flags().GetString("keypath")
+		return runEdit(objects.Manifest{
+			Kind:     objects.KindVaultKey,
+			Metadata: objects.ManifestMetadata{KeyPath: strings.TrimPrefix(keyPath, "secrets/")},
+		})
+	},
+}
+
+func init() {
+	editCmd.AddCommand(editVaultKeyCmd)
+
+	editVaultKeyCmd.Flags().String("keypath", "", "Specify the vault key path")
+	editVaultKeyCmd.MarkFlagRequired("keypath")
+}
@@ -2,100 +2,106 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
+	"strings"
 
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/splicemachine/splicectl/kube"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 )
 
-func getEnvironmentName() string {
+// newKubeFactory - the Kubernetes client factory for the cluster selected by
+// the --kubeconfig, --kube-context and --splice-namespace flags, then the
+// active context, then the matching keys of the config file.
+func newKubeFactory() *kube.Factory {
+	var contextKubeconfig, contextKubeContext, contextNamespace string
+	if activeContext != nil {
+		contextKubeconfig = activeContext.Kubeconfig
+		contextKubeContext = activeContext.KubeContext
+		contextNamespace = activeContext.SpliceNamespace
+	}
 
-	cfg, err := restConfig()
-	if err != nil {
-		logrus.WithError(err).Fatal("could not get config")
-		os.Exit(1)
+	kubeconfig := firstSet(kubeconfigPath, contextKubeconfig, viper.GetString("kubeconfig"))
+	if expanded, err := homedir.Expand(kubeconfig); err == nil {
+		kubeconfig = expanded
+	}
+	return kube.NewFactory(
+		kubeconfig,
+		firstSet(kubeContext, contextKubeContext, viper.GetString("kube-context")),
+		firstSet(spliceNamespace, contextNamespace, viper.GetString("splice-namespace")),
+	)
+}
+
+func firstSet(values ...string) string {
+	for _, v := range values {
+		if len(v) > 0 {
+			return v
+		}
 	}
-	if cfg == nil {
-		return "default"
+	return ""
+}
+
+// kubeClient - the Kubernetes client, nil when there is no kubeconfig and
+// the command doesn't need one.
+func kubeClient() (kubernetes.Interface, error) {
+	client, err := kubeFactory.Clientset()
+	if errors.Is(err, kube.ErrNoKubeconfig) {
+		if commandName != "version" {
+			return nil, withMessage(err, "Could not locate the KUBECONFIG file, normally ~/.kube/config")
+		}
+		return nil, nil
 	}
+	if err != nil {
+		return nil, withMessage(err, "could not create client from config")
+	}
+	return client, nil
+}
 
-	client, err := kubernetes.NewForConfig(cfg)
+func getEnvironmentName() (string, error) {
+
+	client, err := kubeClient()
 	if err != nil {
-		logrus.WithError(err).Fatal("could not create client from config")
-		return "default"
+		return "", err
+	}
+	if client == nil {
+		return "default", nil
 	}
 
-	secretResource, secerr := client.CoreV1().Secrets("splice-system").Get(context.TODO(), "vault-key-store", v1.GetOptions{})
+	secretResource, secerr := client.CoreV1().Secrets(kubeFactory.Namespace()).Get(context.TODO(), "vault-key-store", v1.GetOptions{})
 	if secerr != nil {
 		logrus.WithError(secerr).Error("Secret Not Found vault-key-store")
-		return "default"
+		return "default", nil
 	}
 
-	return string(secretResource.Data["ENVIRONMENT"][:])
+	return string(secretResource.Data["ENVIRONMENT"][:]), nil
 
 }
 
-func getIngressDetail() string {
-	cfg, err := restConfig()
-	if err != nil {
-		logrus.WithError(err).Fatal("could not get config")
-		os.Exit(1)
+// getIngressDetail - discover the API server from the splicectl-api Ingress,
+// Service or Route, an empty string when it can't be found.
+func getIngressDetail() (string, error) {
+	client, err := kubeClient()
+	if err != nil || client == nil {
+		return "", err
 	}
-	if cfg == nil {
-		return ""
-	}
-
-	client, err := kubernetes.NewForConfig(cfg)
+	dynamicClient, err := kubeFactory.Dynamic()
 	if err != nil {
-		logrus.WithError(err).Fatal("could not create client from config")
-		os.Exit(1)
+		logrus.WithError(err).Warn("could not create the dynamic client, OpenShift Routes will not be checked")
 	}
 
-	ingressResult, err := client.ExtensionsV1beta1().Ingresses("splice-system").Get(context.TODO(), "splicectl-api", v1.GetOptions{})
+	found, err := kube.DiscoverServer(context.TODO(), client, dynamicClient, kubeFactory.Namespace())
 	if err != nil {
-		logrus.WithError(err).Warn("could not read from ingress: splicectl-api")
-		return ""
+		logrus.Warn(err.Error())
+		logrus.Warn("use --server-uri, or a context with a server, to set the API server")
+		return "", nil
 	}
-	return fmt.Sprintf("https://%s", ingressResult.Spec.Rules[0].Host)
-
-}
-
-func restConfig() (*rest.Config, error) {
-	// We aren't likely to run this INSIDE the K8s cluster, this routine
-	// simply picks up the config from the file system of a running POD.
-	// kubeCfg, err := rest.InClusterConfig()
-	var kubeCfg *rest.Config
-	var err error
-
-	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
-		kubeCfg, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
-		if err != nil {
-			logrus.Info("No KUBECONFIG ENV")
-			return nil, err
-		}
-	} else {
-		// ENV KUBECONFIG not set, check for ~/.kube/config
-		home, err := homedir.Dir()
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
-		}
-		kubeFile := fmt.Sprintf("%s/%s", home, ".kube/config")
-		if _, err := os.Stat(kubeFile); err != nil {
-			if os.IsNotExist(err) {
-				if os.Args[1] != "version" {
-					logrus.Info("Could not locate the KUBECONFIG file, normally ~/.kube/config")
-					os.Exit(1)
-				}
-				return nil, nil
-			}
-		}
-		kubeCfg, err = clientcmd.BuildConfigFromFlags("", kubeFile)
+	logrus.Debug(fmt.Sprintf("using API server %s from %s", found.Server, found.Source))
+	if strings.HasPrefix(found.Server, "http://") {
+		logrus.Warn(fmt.Sprintf("%s from %s is not using TLS, the session tokens are sent unencrypted, use --server-uri to set an https server", found.Server, found.Source))
 	}
-	return kubeCfg, nil
+	return found.Server, nil
 }
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/client"
+)
+
+// Exit codes returned by splicectl, scripts can rely on these to tell apart
+// the reason a command failed.
+const (
+	exitGeneral      = 1
+	exitDifferences  = 2
+	exitUnauthorized = 3
+	exitNotFound     = 4
+	exitServerError  = 5
+	exitClientError  = 6
+	exitConflict     = 7
+	exitUnsupported  = 8
+	exitTimeout      = 9
+	exitNotReady     = 10
+)
+
+// exitCode - map an error to the exit code of the process
+func exitCode(err error) int {
+	var conflictErr *client.VersionConflictError
+	if errors.As(err, &conflictErr) {
+		return exitConflict
+	}
+	var timeoutErr *waitTimeoutError
+	if errors.As(err, &timeoutErr) {
+		return exitTimeout
+	}
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return exitGeneral
+	}
+	switch {
+	case apiErr.IsUnauthorized():
+		return exitUnauthorized
+	case apiErr.IsNotFound():
+		return exitNotFound
+	case apiErr.IsServerError():
+		return exitServerError
+	default:
+		return exitClientError
+	}
+}
+
+// commandError - a failed command, msg is logged along with err before
+// splicectl exits with the code that matches err.
+type commandError struct {
+	msg string
+	err error
+}
+
+func (e *commandError) Error() string {
+	return fmt.Sprintf("%s: %v", e.msg, e.err)
+}
+
+func (e *commandError) Unwrap() error {
+	return e.err
+}
+
+// withMessage - the error a command returns when err made it fail, msg
+// describes what the command was doing.
+func withMessage(err error, msg string) error {
+	return &commandError{msg: msg, err: err}
+}
+
+// exitStatus - a command that has already reported why it failed, splicectl
+// exits with code without logging anything else.
+type exitStatus struct {
+	code int
+}
+
+func (e *exitStatus) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+// reportError - log err and return the exit code of the process, this is
+// the only place the exit code of a failed command is decided.
+func reportError(err error) int {
+	var status *exitStatus
+	if errors.As(err, &status) {
+		return status.code
+	}
+	var cmdErr *commandError
+	if errors.As(err, &cmdErr) {
+		logrus.WithError(cmdErr.err).Error(cmdErr.msg)
+	} else {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	}
+	return exitCode(err)
+}
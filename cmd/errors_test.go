@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/splicemachine/splicectl/client"
+)
+
+func TestReportError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"general", errors.New("failed"), exitGeneral},
+		{"unauthorized", withMessage(&client.APIError{StatusCode: 401}, "Error getting Default CR Info"), exitUnauthorized},
+		{"not found", withMessage(&client.APIError{StatusCode: 404}, "Error getting Default CR Info"), exitNotFound},
+		{"server error", withMessage(&client.APIError{StatusCode: 503}, "Error getting Default CR Info"), exitServerError},
+		{"conflict", withMessage(&client.VersionConflictError{Expected: 7, Current: 8}, "Refusing to write DefaultCR"), exitConflict},
+		{"differences", &exitStatus{code: exitDifferences}, exitDifferences},
+		{"wrapped exit status", fmt.Errorf("diff: %w", &exitStatus{code: exitDifferences}), exitDifferences},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := reportError(tt.err); code != tt.code {
+				t.Fatalf("expected exit code %d, got: %d", tt.code, code)
+			}
+		})
+	}
+}
+
+func TestWithMessage(t *testing.T) {
+	apiErr := &client.APIError{StatusCode: 404, Message: "not found", Method: "GET", Path: "splicectl/v1/vault/default-cr"}
+	err := withMessage(apiErr, "Error getting Default CR Info")
+	if !errors.Is(err, apiErr) {
+		t.Fatalf("expected the error to wrap the API error")
+	}
+	if err.Error() != "Error getting Default CR Info: GET splicectl/v1/vault/default-cr: 404 not found" {
+		t.Fatalf("unexpected message: %s", err.Error())
+	}
+}
@@ -1,16 +1,9 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
 	"os"
-	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
 	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
@@ -31,112 +24,72 @@ var getDatabaseCRCmd = &cobra.Command{
 	supplied will be used and a message will be displayed letting you know which
 	option was chosen if more than one were supplied.
 `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var dberr error
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("get_database-cr")
-
 		databaseName := common.DatabaseName(cmd)
 		if len(databaseName) == 0 {
 			databaseName, dberr = promptForDatabaseName()
 			if dberr != nil {
-				logrus.Fatal("Could not get a list of Databases", dberr)
+				return withMessage(dberr, "Could not get a list of Databases")
 			}
 		}
-		filePath, _ := cmd.Flags().GetString("file")
 		version, _ := cmd.Flags().GetInt("version")
 
 		out, err := getDatabaseCR(databaseName, version)
 		if err != nil {
-			logrus.WithError(err).Error("Error getting workspace CR Info")
+			return withMessage(err, "Error getting workspace CR Info")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.0.14 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayGetDatabaseV1(out, filePath)
-			}
-		}
-
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayGetDatabaseV2(out, filePath)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayGetDatabaseV1(in string, fp string) {
+func displayGetDatabaseV1(in string) error {
+	fp, _ := getDatabaseCRCmd.Flags().GetString("file")
 	if len(fp) == 0 {
 		fmt.Println(in)
-	} else {
-		objects.WriteToFile(fp, in)
+		return nil
 	}
-	os.Exit(0)
+	if err := objects.WriteToFile(fp, in); err != nil {
+		return withMessage(err, "Could not write the output file")
+	}
+	return nil
 }
-func displayGetDatabaseV2(in string, fp string) {
-	if strings.ToLower(outputFormat) == "raw" {
+func displayGetDatabaseV2(in string) error {
+	fp, _ := getDatabaseCRCmd.Flags().GetString("file")
+	if rawOutput() {
 		fmt.Println(in)
-		os.Exit(0)
+		return nil
 	}
 	var dbCR objects.DatabaseCR
-	marshErr := json.Unmarshal([]byte(in), &dbCR)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
+	if err := decodeResponse(in, &dbCR); err != nil {
+		return err
 	}
 
-	if !formatOverridden {
-		outputFormat = "yaml"
+	if len(fp) == 0 {
+		return printObject(&dbCR, "yaml")
 	}
 
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		dbCR.ToJSON(fp)
-	case "gron":
-		dbCR.ToGRON(fp)
-	case "yaml":
-		dbCR.ToYAML(fp)
-	case "text", "table":
-		dbCR.ToTEXT(noHeaders)
+	file, err := os.Create(fp)
+	if err != nil {
+		return withMessage(err, "Could not create the output file")
 	}
-
+	defer file.Close()
+	return writeObject(file, &dbCR, "yaml")
 }
 
 func getDatabaseCR(dbname string, ver int) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+	out, err := apiClient.DatabaseCRRaw(dbname, ver)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/vault/databasecr?version=%d&database-name=%s", ver, dbname)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting workspace CR Info")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	getCmd.AddCommand(getDatabaseCRCmd)
+	declareFeature(getDatabaseCRCmd, "get_database-cr", displayGetDatabaseV1, displayGetDatabaseV2)
 
 	// add database name and aliases
 	getDatabaseCRCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
@@ -1,14 +1,12 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
+	"encoding/json"
 	"fmt"
-	"os"
+	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
 	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
 	"github.com/spf13/cobra"
@@ -19,6 +17,9 @@ var getDatabaseStatus = &cobra.Command{
 	Short: "Get the status of database.",
 	Long: `EXAMPLES
 	splicectl get database-status --database-name "test"
+	splicectl get database-status --database-name "test" -o wide
+	splicectl get database-status --database-name "test" --exit-code
+	splicectl get database-status --database-name "test" --watch
 
 	Note: --database-name and -d are the preferred way to supply the database name.
 	However, --database and --workspace can also be used as well. In the event that
@@ -27,73 +28,99 @@ var getDatabaseStatus = &cobra.Command{
 	supplied will be used and a message will be displayed letting you know which
 	option was chosen if more than one were supplied.
 `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var dberr error
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("get_database-status")
-
 		databaseName := common.DatabaseName(cmd)
 		if len(databaseName) == 0 {
 			databaseName, dberr = promptForDatabaseName()
 			if dberr != nil {
-				logrus.Fatal("Could not get name of Database", dberr)
+				return withMessage(dberr, "Could not get name of Database")
 			}
 		}
 
+		if watching(cmd) {
+			return runWatch(cmd, watchDatabaseStatus(databaseName))
+		}
+
 		out, err := getDatabaseStatusData(databaseName)
 		if err != nil {
-			logrus.WithError(err).Error("Error getting status of database ")
+			return withMessage(err, "Error getting status of database ")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.1.6"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayGetDatabaseStatusV1(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayGetDatabaseStatusV1(in string) {
-	fmt.Println(in)
-	os.Exit(0)
+// displayGetDatabaseStatusV1 - print the readiness of each component.  A
+// response this splicectl can't decode is printed as the server returned it,
+// and --exit-code doesn't check it.
+func displayGetDatabaseStatusV1(in string) error {
+	exitCode, _ := getDatabaseStatus.Flags().GetBool("exit-code")
+	var status objects.DatabaseStatus
+	if err := json.Unmarshal([]byte(in), &status); err != nil {
+		logrus.WithError(err).Debug("Could not decode the database status, printing the response of the server")
+		if exitCode {
+			logrus.Warn("The database status has no component readiness, --exit-code can't check it")
+		}
+		fmt.Println(in)
+		return nil
+	}
+	if rawOutput() {
+		fmt.Println(in)
+	} else if err := printObject(&status, "table"); err != nil {
+		return err
+	}
+	if !exitCode {
+		return nil
+	}
+	return checkDatabaseReady(&status)
 }
 
-func getDatabaseStatusData(databaseName string) (string, error) {
+// checkDatabaseReady - fail with exitNotReady when a component of the
+// database isn't ready
+func checkDatabaseReady(status *objects.DatabaseStatus) error {
+	if notReady := status.NotReady(); len(notReady) > 0 {
+		logrus.Error(fmt.Sprintf("Components not ready: %s", strings.Join(notReady, ", ")))
+		return &exitStatus{code: exitNotReady}
+	}
+	return nil
+}
 
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+func getDatabaseStatusData(databaseName string) (string, error) {
+	out, err := apiClient.DatabaseStatusRaw(databaseName)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/splicedb/splicedatabasestatus?database-name=%s", databaseName)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
+	return string(out[:]), nil
+}
 
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Database status info")
-		return "", resperr
+// watchDatabaseStatus - poll the status of the database for --watch, the
+// rows are keyed by component
+func watchDatabaseStatus(databaseName string) watchFetch {
+	return func() (watchState, error) {
+		status, err := apiClient.DatabaseStatus(databaseName)
+		if err != nil {
+			return watchState{}, withMessage(err, "Error getting status of database ")
+		}
+		state := watchState{table: status.Table(), statuses: map[string]string{}}
+		for _, row := range state.table.Rows {
+			// COMPONENT, READY, STATUS
+			state.names = append(state.names, row[0])
+			state.statuses[row[0]] = row[2] + " " + row[1]
+		}
+		return state, nil
 	}
-	return string(resp.Body()[:]), nil
 }
 
 func init() {
 	getCmd.AddCommand(getDatabaseStatus)
+	declareFeature(getDatabaseStatus, "get_database-status", displayGetDatabaseStatusV1)
 
 	// add database name and aliases
 	getDatabaseStatus.Flags().StringP("database-name", "d", "", "Specify the database name")
 	getDatabaseStatus.Flags().String("database", "", "Alias for database-name, prefer the use of -d and --database-name.")
 	getDatabaseStatus.Flags().String("workspace", "", "Alias for database-name, prefer the use of -d and --database-name.")
+	getDatabaseStatus.Flags().Bool("exit-code", false, "Exit with code 10 when a component of the database isn't ready")
+	addWatchFlags(getDatabaseStatus)
 }
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestWatchDatabaseStatus(t *testing.T) {
+	withServer(t, "v0.1.7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database":"splicedb","components":[{"component":"hbase","pods":[{"name":"hbase-0","ready":false}]}]}`)
+	})
+	state, err := watchDatabaseStatus("splicedb")()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(state.names) != len(state.table.Rows) || state.statuses["hbase"] != "NotReady 0/1" || state.statuses["kafka"] != "Missing 0/0" {
+		t.Fatalf("expected the readiness of each component, got: %+v", state)
+	}
+
+	status, err := apiClient.DatabaseStatus("splicedb")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var exit *exitStatus
+	if err := checkDatabaseReady(&status); !errors.As(err, &exit) || exit.code != exitNotReady {
+		t.Fatalf("expected exit code %d, got: %v", exitNotReady, err)
+	}
+}
+
+func TestDisplayGetDatabaseStatusFallback(t *testing.T) {
+	getDatabaseStatus.Flags().Set("exit-code", "true")
+	defer getDatabaseStatus.Flags().Set("exit-code", "false")
+
+	if err := displayGetDatabaseStatusV1(`{"database":"splicedb","state":"Running"}`); err != nil {
+		t.Fatalf("expected an unknown response to be printed as is, got: %v", err)
+	}
+	if err := displayGetDatabaseStatusV1(`not json`); err != nil {
+		t.Fatalf("expected a response that isn't JSON to be printed as is, got: %v", err)
+	}
+	var exit *exitStatus
+	err := displayGetDatabaseStatusV1(`{"database":"splicedb","components":[{"component":"hbase","pods":[]}]}`)
+	if !errors.As(err, &exit) || exit.code != exitNotReady {
+		t.Fatalf("expected exit code %d, got: %v", exitNotReady, err)
+	}
+}
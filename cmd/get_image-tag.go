@@ -1,16 +1,8 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
-	"os"
-	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
 	"github.com/splicemachine/splicectl/cmd/objects"
 
 	"github.com/spf13/cobra"
@@ -22,113 +14,55 @@ var getImageTag = &cobra.Command{
 	Long: `EXAMPLES
 	splicectl get image-tag --component-name "hbase" --database-name "cjdb"
 `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var dberr error
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("get_image-tag")
-
 		componentName, _ := cmd.Flags().GetString("component-name")
 		databaseName, _ := cmd.Flags().GetString("database-name")
 		if len(databaseName) == 0 {
 			databaseName, dberr = promptForDatabaseName()
 			if dberr != nil {
-				logrus.Fatal("Could not get a list of Databases", dberr)
+				return withMessage(dberr, "Could not get a list of Databases")
 			}
 		}
 
 		out, err := getImageTagData(componentName, databaseName)
 		if err != nil {
-			logrus.WithError(err).Error("Error getting image tag for component")
-		}
-
-		if semverV1, err := semver.ParseRange(">=0.0.16 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayGetImageTagV1(out)
-			}
+			return withMessage(err, "Error getting image tag for component")
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayGetImageTagV2(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayGetImageTagV1(in string) {
+func displayGetImageTagV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayGetImageTagV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
+func displayGetImageTagV2(in string) error {
+	if rawOutput() {
 		fmt.Println(in)
-		os.Exit(0)
-	}
-
-	var tags []objects.ImageTag
-
-	marshErr := json.Unmarshal([]byte(in), &tags)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
+		return nil
 	}
-
-	if !formatOverridden {
-		outputFormat = "table"
-	}
-
-	tagList := objects.ImageTagList{
-		ImageTags: tags,
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		tagList.ToJSON()
-	case "gron":
-		tagList.ToGRON()
-	case "yaml":
-		tagList.ToYAML()
-	case "text", "table":
-		tagList.ToTEXT(noHeaders)
+	var tagList objects.ImageTagList
+	if err := decodeResponse(in, &tagList.ImageTags); err != nil {
+		return err
 	}
-
+	return printObject(&tagList, "table")
 }
 
-func getImageTagData(componenetName string, databaseName string) (string, error) {
-
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+func getImageTagData(componentName string, databaseName string) (string, error) {
+	out, err := apiClient.ImageTagsRaw(componentName, databaseName)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/splicedb/imagetag?component-name=%s&database-name=%s", componenetName, databaseName)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Default CR Info")
-		return "", resperr
-	}
-	return string(resp.Body()[:]), nil
+	return string(out[:]), nil
 }
 
 func init() {
 	getCmd.AddCommand(getImageTag)
+	declareFeature(getImageTag, "get_image-tag", displayGetImageTagV1, displayGetImageTagV2)
 
 	getImageTag.Flags().StringP("component-name", "c", "", "Specify the component")
 	getImageTag.Flags().StringP("database-name", "d", "", "Specify the database name")
@@ -1,15 +1,12 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"fmt"
-	"os"
+	"regexp"
 	"strings"
+	"time"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/splicemachine/splicectl/cmd/objects"
 
@@ -22,13 +19,12 @@ var listDatabaseCmd = &cobra.Command{
 	Short:   "Retrieve a list of splice databases in the cluster.",
 	Long: `EXAMPLES
 	splicectl list workspace
+	splicectl list workspace --account engineering --free-tier=false --sort-by created
+	splicectl list workspace --name '^dev-' --created-before 2021-01-01 -o wide
+	splicectl list workspace --watch --interval 10s
+	splicectl list workspace --watch -o json
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("list_database")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		// check active and paused flag values
 		active, err := cmd.Flags().GetBool("active")
 		if err != nil {
@@ -40,112 +36,149 @@ var listDatabaseCmd = &cobra.Command{
 			logrus.WithError(err).Error("Error getting Database CR Info")
 		}
 
-		// databaseName, _ := cmd.Flags().GetString("database-name")
-		out, err := getDatabaseListWithFlags(active, paused)
+		filter, err := workspaceFilter(cmd)
 		if err != nil {
-			logrus.WithError(err).Error("Error getting Database CR Info")
+			return err
 		}
-
-		if semverV1, err := semver.ParseRange(">=0.0.14 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayListDatabaseV1(out)
+		sortBy, _ := cmd.Flags().GetString("sort-by")
+		if len(sortBy) > 0 {
+			if err := objects.ValidateWorkspaceSort(sortBy); err != nil {
+				return err
 			}
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayListDatabaseV2(out)
-			}
+		if watching(cmd) {
+			return runWatch(cmd, watchDatabaseList(active, paused, filter, sortBy))
 		}
+
+		// databaseName, _ := cmd.Flags().GetString("database-name")
+		out, err := getDatabaseListWithFlags(active, paused, filter, sortBy)
+		if err != nil {
+			return withMessage(err, "Error getting Database CR Info")
+		}
+
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayListDatabaseV1(in string) {
+func displayListDatabaseV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayListDatabaseV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
+func displayListDatabaseV2(in string) error {
 	var dbList objects.DatabaseList
-
-	marshErr := json.Unmarshal([]byte(in), &dbList)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "table"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		dbList.ToJSON()
-	case "gron":
-		dbList.ToGRON()
-	case "yaml":
-		dbList.ToYAML()
-	case "text", "table":
-		dbList.ToTEXT(noHeaders)
-	}
-
+	return displayResponse(in, &dbList, "table")
 }
 
 // getDatabaseList - simple wrapper around getDatabaseListWithFlags to prevent
 // cascading issues with change in API.
 func getDatabaseList() (string, error) {
-	return getDatabaseListWithFlags(false, false)
+	return getDatabaseListWithFlags(false, false, objects.WorkspaceFilter{}, "")
 }
 
 // getDatabaseListWithFlags - gets list of databases and filters/orders them
 // based on flags.
-func getDatabaseListWithFlags(active, paused bool) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+func getDatabaseListWithFlags(active, paused bool, filter objects.WorkspaceFilter, sortBy string) (string, error) {
+	filtered, err := filteredDatabaseList(active, paused, filter, sortBy)
+	if err != nil {
+		return "", err
 	}
+	filteredDBList, err := json.Marshal(filtered)
+	// TODO: return either bytes or json, do not go back to strings
+	return string(filteredDBList), err
 
-	uri := "splicectl/v1/splicedb/splicedatabase"
-	resp, resperr := restClient.R().
-		// SetHeader("Content-Type", "application/json").
-		// SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Execute("LIST", fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Database List")
-		return "", resperr
+}
+
+// filteredDatabaseList - the list of databases filtered and ordered based on
+// flags
+func filteredDatabaseList(active, paused bool, filter objects.WorkspaceFilter, sortBy string) (*objects.DatabaseList, error) {
+	dbList, err := apiClient.DatabaseList()
+	if err != nil {
+		return nil, err
 	}
 
 	// filter and order DBList returned from api call
-	rawRespBody, dbList := resp.Body()[:], &objects.DatabaseList{}
-	if err := json.Unmarshal(rawRespBody, dbList); err != nil {
-		return "", err
+	filtered := dbList.FilterByStatus(active, paused).Filter(filter)
+	if len(sortBy) > 0 {
+		if err := filtered.SortBy(sortBy); err != nil {
+			return nil, err
+		}
 	}
-	dbList = dbList.FilterByStatus(active, paused)
-	filteredDBList, err := json.Marshal(dbList)
-	// TODO: return either bytes or json, do not go back to strings
-	return string(filteredDBList), err
+	return filtered, nil
+}
+
+// watchDatabaseList - poll the filtered list of databases for --watch, the
+// rows are keyed by database name
+func watchDatabaseList(active, paused bool, filter objects.WorkspaceFilter, sortBy string) watchFetch {
+	return func() (watchState, error) {
+		dbList, err := filteredDatabaseList(active, paused, filter, sortBy)
+		if err != nil {
+			return watchState{}, withMessage(err, "Error getting Database CR Info")
+		}
+		state := watchState{table: dbList.Table(), statuses: map[string]string{}}
+		for _, info := range dbList.Clusters {
+			state.names = append(state.names, info.DcosAppId)
+			state.statuses[info.DcosAppId] = info.Status
+		}
+		return state, nil
+	}
+}
 
+// workspaceFilter - the filters of list workspace given on the command line
+func workspaceFilter(cmd *cobra.Command) (objects.WorkspaceFilter, error) {
+	filter := objects.WorkspaceFilter{}
+	filter.Account, _ = cmd.Flags().GetString("account")
+	filter.Owner, _ = cmd.Flags().GetString("owner")
+
+	if name, _ := cmd.Flags().GetString("name"); len(name) > 0 {
+		re, err := regexp.Compile(name)
+		if err != nil {
+			return filter, fmt.Errorf("invalid --name regular expression: %v", err)
+		}
+		filter.Name = re
+	}
+	var err error
+	if filter.CreatedBefore, err = timeFlag(cmd, "created-before"); err != nil {
+		return filter, err
+	}
+	if filter.CreatedAfter, err = timeFlag(cmd, "created-after"); err != nil {
+		return filter, err
+	}
+	if cmd.Flags().Changed("free-tier") {
+		freeTier, _ := cmd.Flags().GetBool("free-tier")
+		filter.FreeTier = &freeTier
+	}
+	return filter, nil
+}
+
+// timeFlag - the time of an RFC3339 or YYYY-MM-DD flag, zero when it's not
+// given
+func timeFlag(cmd *cobra.Command, name string) (time.Time, error) {
+	value, _ := cmd.Flags().GetString(name)
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("--%s %q must be an RFC3339 time or a YYYY-MM-DD date", name, value)
 }
 
 func init() {
 	listCmd.AddCommand(listDatabaseCmd)
+	declareFeature(listDatabaseCmd, "list_database", displayListDatabaseV1, displayListDatabaseV2)
 
 	listDatabaseCmd.Flags().BoolP("active", "a", false, "Select if you want to get active databases.")
 	listDatabaseCmd.Flags().BoolP("paused", "p", false, "Select if you want to get paused databases.")
+	listDatabaseCmd.Flags().String("account", "", "Only list the databases of the account, by name or id, globs are allowed")
+	listDatabaseCmd.Flags().String("owner", "", "Only list the databases of the user email, globs are allowed")
+	listDatabaseCmd.Flags().String("name", "", "Only list the databases whose name matches the regular expression")
+	listDatabaseCmd.Flags().String("created-before", "", "Only list the databases created before the RFC3339 time or YYYY-MM-DD date")
+	listDatabaseCmd.Flags().String("created-after", "", "Only list the databases created after the RFC3339 time or YYYY-MM-DD date")
+	listDatabaseCmd.Flags().Bool("free-tier", false, "Only list the free tier databases, --free-tier=false lists the others")
+	listDatabaseCmd.Flags().String("sort-by", "", fmt.Sprintf("Sort the databases by %s", strings.Join(objects.WorkspaceSortFields(), "|")))
+	addWatchFlags(listDatabaseCmd)
 }
@@ -11,8 +11,10 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/splicemachine/splicectl/auth"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
-	"github.com/splicemachine/splicectl/common"
+	"github.com/splicemachine/splicectl/kube"
+	"github.com/splicemachine/splicectl/printer"
 
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/spf13/viper"
@@ -42,6 +44,18 @@ var caBundle string
 var formatOverridden bool
 var noHeaders bool
 var authClient auth.Client
+var apiClient *client.Client
+var contextName string
+var activeContext *objects.Context
+var kubeconfigPath string
+var kubeContext string
+var spliceNamespace string
+var kubeFactory *kube.Factory
+var portForward bool
+var environmentName string
+var sessionStore auth.SessionStore
+var autoRenewSession bool
+var commandName string
 
 // rootCmd represents the base command when called without any subcommands
 // splicectl doesn't have any functionality, other than to validate our auth
@@ -53,39 +67,75 @@ var rootCmd = &cobra.Command{
 	Long: `splicectl is a CLI tool for making managment of Splice Machine
 database clusters under Kubernetes easier to manage.`,
 	Args: cobra.MinimumNArgs(1),
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// the flags and arguments were valid, errors from here on aren't
+		// usage errors
+		cmd.SilenceUsage = true
+		commandName = topCommand(cmd)
+		if err := initConfig(); err != nil {
+			return err
+		}
 
-		if len(caCert) > 0 {
-			if _, err := os.Stat(caCert); err != nil {
-				if os.IsNotExist(err) {
-					logrus.Info("Couldn't read the ca-file, please check the path")
-					os.Exit(1)
-				}
-			}
-			fileBytes, _ := ioutil.ReadFile(caCert)
-			caBundle = strings.TrimSpace(string(fileBytes[:]))
-		} else {
+		var cerr error
+		if activeContext, cerr = selectContext(); cerr != nil {
+			return withMessage(cerr, "Error selecting the context")
+		}
+		kubeFactory = newKubeFactory()
+		if sessionStore, cerr = newSessionStore(); cerr != nil {
+			return withMessage(cerr, "Error selecting the session store")
+		}
+
+		if len(caCert) == 0 {
 			caCert = os.Getenv("SPLICECTL_CACERT")
-			if len(caCert) > 0 {
-				if _, err := os.Stat(caCert); err != nil {
-					if os.IsNotExist(err) {
-						logrus.Info("Couldn't read the ca-file, please check the path")
-						os.Exit(1)
-					}
-				}
+			if len(caCert) == 0 && activeContext != nil {
+				caCert = activeContext.CACert
+			}
+		}
+		if len(caCert) > 0 {
+			fileBytes, err := ioutil.ReadFile(caCert)
+			if err != nil {
+				return withMessage(err, "Couldn't read the ca-file, please check the path")
 			}
-			fileBytes, _ := ioutil.ReadFile(caCert)
 			caBundle = strings.TrimSpace(string(fileBytes[:]))
 		}
 
-		apiServer = getIngressDetail()
-		if len(serverURI) > 0 {
+		discovered := false
+		var err error
+		switch {
+		case portForward || viper.GetBool("port-forward"):
+			apiServer, err = startPortForward()
+		case len(serverURI) > 0:
 			apiServer = serverURI
+		case activeContext != nil && len(activeContext.Server) > 0:
+			apiServer = activeContext.Server
+		default:
+			discovered = true
+			apiServer, err = getIngressDetail()
+			if err == nil && len(apiServer) == 0 {
+				apiServer, err = fallbackPortForward()
+			}
 		}
+		if err != nil {
+			return err
+		}
+		apiClient = client.New(apiServer, caBundle, nil)
 
 		// Collect the version info, for use in determining valid commands based on SemVer
 		if apiServer != "" {
 			version, err := getVersionInfo()
+			if err != nil && discovered && activePortForward == nil && unreachable(err) {
+				// private clusters often don't expose the ingress
+				logrus.WithError(err).Warn(fmt.Sprintf("%s is not reachable, trying a port-forward", apiServer))
+				forwarded, ferr := fallbackPortForward()
+				if ferr != nil {
+					return ferr
+				}
+				if len(forwarded) > 0 {
+					apiServer = forwarded
+					apiClient = client.New(apiServer, caBundle, nil)
+					version, err = getVersionInfo()
+				}
+			}
 			if err != nil {
 				logrus.WithError(err).Error("Error getting version info")
 			}
@@ -102,70 +152,118 @@ database clusters under Kubernetes easier to manage.`,
 			logrus.WithError(marsherr).Error("Error decoding json for Version")
 		}
 
-		if os.Args[1] != "version" {
-			environment := getEnvironmentName()
-			authClient = auth.NewAuth(environment, common.SessionData{
-				SessionID:  fmt.Sprintf("%s", viper.Get(fmt.Sprintf("%s-session_id", environment))),
-				ValidUntil: fmt.Sprintf("%s", viper.Get(fmt.Sprintf("%s-valid_until", environment))),
-			})
-			isValid := authClient.CheckTokenValidity()
-			if !isValid && os.Args[1] != "auth" {
+		if commandName != "version" {
+			environment, err := getEnvironmentName()
+			if err != nil {
+				return err
+			}
+			environmentName = environment
+			session, err := loadSession(environment)
+			if err != nil {
+				return withMessage(err, "Error reading the session")
+			}
+			bearerProvider, err := newBearerProvider(environment)
+			if err != nil {
+				return withMessage(err, "Error reading the bearer provider")
+			}
+			authClient = auth.NewAuth(environment, bearerProvider, session)
+			apiClient.SetAuth(authClient)
+			if commandName != "auth" {
+				autoRenew(session)
+			}
+			isValid, err := authClient.CheckTokenValidity()
+			if err != nil && commandName != "auth" {
+				return withMessage(err, "Error checking the session")
+			}
+			if !isValid && commandName != "auth" {
 				logrus.Info("Your session has expired, please run the 'auth' again.")
-				os.Exit(1)
+				return &exitStatus{code: exitGeneral}
+			}
+		}
+
+		// Check the server supports the feature the command declared, see
+		// declareFeature
+		if feature, ok := cmd.Annotations[featureAnnotation]; ok {
+			if err := requireFeature(feature); err != nil {
+				return err
 			}
 		}
 
 		// Validate global parameters here, BEFORE we start to waste time
 		// and run any code.
-		if outputFormat != "" {
-			outputFormat = strings.ToLower(outputFormat)
-			switch outputFormat {
-			case "json":
-			case "gron":
-			case "yaml":
-			case "text":
-			case "table":
-			case "raw":
-			default:
-				fmt.Println("Valid options for -o are [json|gron|[text|table]|yaml|raw]")
-				os.Exit(1)
-			}
-			formatOverridden = true
-		} else {
-			formatOverridden = false
-			outputFormat = "json"
-		}
+		return validateOutputFormat()
 	},
 }
 
+// topCommand - the name of the splicectl command being run, ie: auth for
+// splicectl auth status
+func topCommand(cmd *cobra.Command) string {
+	for cmd.HasParent() && cmd.Parent().HasParent() {
+		cmd = cmd.Parent()
+	}
+	return cmd.Name()
+}
+
+// validateOutputFormat - check the -o flag, the format name is lower cased
+// and json is used when it wasn't given.
+func validateOutputFormat() error {
+	if outputFormat != "" {
+		// Only the format name is lower cased, the jsonpath and template
+		// arguments are case sensitive.
+		name, arg := printer.SplitFormat(outputFormat)
+		outputFormat = name
+		if len(arg) > 0 {
+			outputFormat = fmt.Sprintf("%s=%s", name, arg)
+		}
+		if outputFormat != "raw" {
+			if err := printer.Validate(outputFormat); err != nil {
+				return fmt.Errorf("%v\nValid options for -o are [json|gron|[text|table]|yaml|raw|jsonpath=...|go-template=...|custom-columns=...]", err)
+			}
+		}
+		formatOverridden = true
+	} else {
+		formatOverridden = false
+		outputFormat = "json"
+	}
+	return nil
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+	err := rootCmd.Execute()
+	stopPortForward()
+	if err != nil {
+		os.Exit(reportError(err))
 	}
 }
 
 func init() {
-	cobra.OnInitialize(initConfig)
+	rootCmd.SilenceErrors = true
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.splicectl/config.yml)")
 	rootCmd.PersistentFlags().StringVar(&serverURI, "server-uri", "", "override the server uri for the API server http(s)://host.domain.name:overrideport")
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "", "output types: json, text, yaml, gron")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "", "output types: json, text, wide, yaml, gron, raw, jsonpath=..., go-template=..., custom-columns=...")
 	rootCmd.PersistentFlags().BoolVar(&noHeaders, "no-headers", false, "Suppress header output in Text output")
 	rootCmd.PersistentFlags().StringVar(&caCert, "cacert", "", "Specify a cacert file to use to authenticate the SSL certificate")
+	rootCmd.PersistentFlags().StringVar(&contextName, "context", "", "The name of the splicectl context to use, see 'splicectl config get-contexts'")
+	rootCmd.PersistentFlags().StringVar(&kubeconfigPath, "kubeconfig", "", "Path to the kubeconfig file (default is $KUBECONFIG or $HOME/.kube/config)")
+	rootCmd.PersistentFlags().StringVar(&kubeContext, "kube-context", "", "The kubeconfig context to use (default is the current context)")
+	rootCmd.PersistentFlags().StringVar(&spliceNamespace, "splice-namespace", "", "The namespace splicectl-api is installed in (default is splice-system)")
+	rootCmd.PersistentFlags().BoolVar(&autoRenewSession, "auto-renew", false, "Request a new session when the stored session has expired or expires soon")
+	rootCmd.PersistentFlags().BoolVar(&portForward, "port-forward", false, "Reach the API server through a port-forward to the splicectl-api pod instead of the ingress")
 }
 
-func initConfig() {
+func initConfig() error {
 	if cfgFile != "" {
 		// Use config file from the flag.
 		if _, err := os.Stat(cfgFile); err != nil {
 			if os.IsNotExist(err) {
-				if os.Args[1] != "auth" {
+				if commandName != "auth" && commandName != "config" {
 					logrus.Info("Couldn't read the config file.  We require a session ID from the splicectl API.  Please run with 'auth'.")
-					os.Exit(1)
-				} else {
-					createRestrictedConfigFile(cfgFile)
+					return &exitStatus{code: exitGeneral}
+				}
+				if err := createRestrictedConfigFile(cfgFile); err != nil {
+					return err
 				}
 			}
 		}
@@ -174,8 +272,7 @@ func initConfig() {
 		// Find home directory.
 		home, err := homedir.Dir()
 		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
+			return err
 		}
 
 		directory := fmt.Sprintf("%s/%s", home, ".splicectl")
@@ -186,32 +283,35 @@ func initConfig() {
 		}
 		if stat, err := os.Stat(directory); err == nil && stat.IsDir() {
 			configFile := fmt.Sprintf("%s/%s", home, ".splicectl/config.yml")
-			createRestrictedConfigFile(configFile)
+			if err := createRestrictedConfigFile(configFile); err != nil {
+				return err
+			}
 			viper.SetConfigFile(configFile)
 		} else {
 			logrus.Info("The ~/.splicectl path is a file and not a directory, please remove the .splicectl file.")
-			os.Exit(1)
+			return &exitStatus{code: exitGeneral}
 		}
 	}
 
 	// viper.AutomaticEnv() // read in environment variables that match
+	viper.BindEnv("session-store", "SPLICECTL_SESSION_STORE")
 
 	// If a config file is found, read it in.
 	if err := viper.ReadInConfig(); err != nil {
-		if os.Args[1] != "auth" {
+		if commandName != "auth" && commandName != "config" {
 			logrus.Info("Couldn't read the config file.  We require a session ID from the splicectl API.  Please run with 'auth'.")
-			os.Exit(1)
+			return &exitStatus{code: exitGeneral}
 		}
 	}
+	return nil
 }
 
-func createRestrictedConfigFile(fileName string) {
+func createRestrictedConfigFile(fileName string) error {
 	if _, err := os.Stat(fileName); err != nil {
 		if os.IsNotExist(err) {
 			file, ferr := os.Create(fileName)
 			if ferr != nil {
-				logrus.Info("Unable to create the configfile.")
-				os.Exit(1)
+				return withMessage(ferr, "Unable to create the configfile.")
 			}
 			mode := int(0600)
 			if cherr := file.Chmod(os.FileMode(mode)); cherr != nil {
@@ -219,16 +319,17 @@ func createRestrictedConfigFile(fileName string) {
 			}
 		}
 	}
+	return nil
 }
 
 // ClientSemVer - returns the full semVer as the first string and the numerical
 // portion as the second string, they may be identical. One example where they
 // would not be is:
 //         semVer: v0.1.1-cacert -> (v0.1.1-cacert, v0.1.1).
-func ClientSemVer() (string, string) {
+func ClientSemVer() (string, string, error) {
 	submatches := semVerReg.FindStringSubmatch(semVer)
 	if submatches == nil || len(submatches) < 2 {
-		logrus.Fatalf("the semver in the current build is not valid: %s", semVer)
+		return "", "", fmt.Errorf("the semver in the current build is not valid: %s", semVer)
 	}
-	return submatches[0], submatches[1]
+	return submatches[0], submatches[1], nil
 }
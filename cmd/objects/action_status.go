@@ -1,16 +1,9 @@
 package objects
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"os"
-	"strings"
 
-	"github.com/maahsome/gron"
-	"github.com/olekukonko/tablewriter"
-	"github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v2"
+	"github.com/splicemachine/splicectl/printer"
 )
 
 // ActionStatus - Status of various actions
@@ -21,82 +14,24 @@ type ActionStatus struct {
 	Error    string `json:"error"`
 }
 
-// ToJSON - Write the output as JSON
-func (as *ActionStatus) ToJSON() error {
-
-	asJSON, enverr := json.MarshalIndent(as, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-	fmt.Println(string(asJSON[:]))
-
-	return nil
-
+// Table - the table output of the action status
+func (as *ActionStatus) Table() printer.Table {
+	table := printer.NewTable("PROCESS", "SUCCESS", "DATABASE", "ERROR")
+	table.AddRow(as.Process, fmt.Sprintf("%t", as.Success), as.Database, as.Error)
+	return table
 }
 
-// ToGRON - Write the output as GRON
-func (as *ActionStatus) ToGRON() error {
-	asJSON, enverr := json.MarshalIndent(as, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
+// Err - the failure reported by the server, nil when the action succeeded
+func (as *ActionStatus) Err() error {
+	if as.Success {
+		return nil
 	}
-
-	subReader := strings.NewReader(string(asJSON[:]))
-	subValues := &bytes.Buffer{}
-	ges := gron.NewGron(subReader, subValues)
-	ges.SetMonochrome(false)
-	serr := ges.ToGron()
-	if serr != nil {
-		logrus.Error("Problem generating gron syntax", serr)
-		return serr
+	action := as.Process
+	if len(as.Database) > 0 {
+		action = fmt.Sprintf("%s of %s", as.Process, as.Database)
 	}
-	fmt.Println(string(subValues.Bytes()))
-
-	return nil
-
-}
-
-// ToYAML - Write the output as YAML
-func (as *ActionStatus) ToYAML() error {
-
-	asYAML, enverr := yaml.Marshal(as)
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting yaml")
-		return enverr
+	if len(as.Error) == 0 {
+		return fmt.Errorf("%s failed", action)
 	}
-	fmt.Println(string(asYAML[:]))
-
-	return nil
-
-}
-
-// ToTEXT - Write the output as TEXT
-func (as *ActionStatus) ToTEXT(noHeaders bool) error {
-
-	var row []string
-
-	// ******************** TableWriter *******************************
-	table := tablewriter.NewWriter(os.Stdout)
-	if !noHeaders {
-		table.SetHeader([]string{"PROCESS", "SUCCESS", "DATABASE", "ERROR"})
-		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
-	}
-	table.SetAutoWrapText(false)
-	table.SetAutoFormatHeaders(true)
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetCenterSeparator("")
-	table.SetColumnSeparator("")
-	table.SetRowSeparator("")
-	table.SetHeaderLine(false)
-	table.SetBorder(false)
-	table.SetTablePadding("\t") // pad with tabs
-	table.SetNoWhiteSpace(true)
-	row = []string{as.Process, fmt.Sprintf("%t", as.Success), as.Database, as.Error}
-	table.Append(row)
-	table.Render()
-
-	return nil
-
+	return fmt.Errorf("%s failed: %s", action, as.Error)
 }
@@ -0,0 +1,26 @@
+package objects
+
+import "testing"
+
+func TestActionStatusErr(t *testing.T) {
+	tests := []struct {
+		status ActionStatus
+		want   string
+	}{
+		{ActionStatus{Process: "pause", Success: true, Database: "splicedb"}, ""},
+		{ActionStatus{Process: "pause", Database: "splicedb", Error: "not active"}, "pause of splicedb failed: not active"},
+		{ActionStatus{Process: "restart"}, "restart failed"},
+	}
+	for _, tt := range tests {
+		err := tt.status.Err()
+		if len(tt.want) == 0 {
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.want {
+			t.Fatalf("expected %q, got: %v", tt.want, err)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package objects
+
+import (
+	"fmt"
+
+	"github.com/splicemachine/splicectl/printer"
+)
+
+// AuthStatus - the stored session of the current environment or context
+type AuthStatus struct {
+	Environment string `json:"environment"`
+	Context     string `json:"context,omitempty"`
+	SessionID   string `json:"session_id"`
+	ValidUntil  string `json:"valid_until"`
+	Remaining   string `json:"remaining"`
+	Valid       bool   `json:"valid"`
+}
+
+// Table - the table output of the session status
+func (s *AuthStatus) Table() printer.Table {
+	table := printer.NewTable("ENVIRONMENT", "CONTEXT", "SESSION", "VALID_UNTIL", "REMAINING", "VALID")
+	table.AddRow(s.Environment, s.Context, s.SessionID, s.ValidUntil, s.Remaining, fmt.Sprintf("%t", s.Valid))
+	return table
+}
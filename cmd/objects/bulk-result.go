@@ -0,0 +1,49 @@
+package objects
+
+import (
+	"github.com/splicemachine/splicectl/printer"
+)
+
+// The results of a bulk operation on a workspace
+const (
+	ResultSucceeded = "succeeded"
+	ResultSkipped   = "skipped"
+	ResultFailed    = "failed"
+)
+
+// BulkResult - the outcome of a bulk operation on one workspace
+type BulkResult struct {
+	Database string `json:"database"`
+	Result   string `json:"result"`
+	Message  string `json:"message,omitempty"`
+}
+
+// BulkResultList - the outcome of a bulk operation on each workspace
+type BulkResultList struct {
+	Results []BulkResult `json:"results"`
+}
+
+// Failed - the number of workspaces the operation failed on
+func (l *BulkResultList) Failed() int {
+	failed := 0
+	for _, result := range l.Results {
+		if result.Result == ResultFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+// Items - the results, one row each for custom-columns
+func (l *BulkResultList) Items() interface{} {
+	return l.Results
+}
+
+// Table - the table output of the results
+func (l *BulkResultList) Table() printer.Table {
+	table := printer.NewTable("DATABASE", "RESULT", "MESSAGE")
+	for _, result := range l.Results {
+		table.AddRow(result.Database, result.Result, result.Message)
+	}
+	return table
+}
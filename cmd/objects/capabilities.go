@@ -0,0 +1,98 @@
+package objects
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/splicemachine/splicectl/printer"
+)
+
+// The origin of the capabilities
+const (
+	CapabilitiesServer = "server"
+	CapabilitiesStatic = "static"
+)
+
+// ResponseVersions - for servers without the capabilities endpoint, the
+// server version that introduced each response version of a feature after
+// the first, ie: the second response version of get_default-cr came with
+// 0.0.17.  The first response version comes with CommandVersions.
+var ResponseVersions = map[string][]string{
+	"apply_database-cr":        {"0.0.17"},
+	"apply_default-cr":         {"0.0.17"},
+	"apply_system-settings":    {"0.0.17"},
+	"apply_vault-key":          {"0.0.17"},
+	"get_database-cr":          {"0.0.17"},
+	"get_default-cr":           {"0.0.17"},
+	"get_image-tag":            {"0.0.17"},
+	"get_system-settings":      {"0.0.17"},
+	"get_vault-key":            {"0.0.17"},
+	"list_database":            {"0.0.17"},
+	"rollback_database-cr":     {"0.0.17"},
+	"rollback_default-cr":      {"0.0.17"},
+	"rollback_system-settings": {"0.0.17"},
+	"rollback_vault-key":       {"0.0.17"},
+	"versions_database-cr":     {"0.0.17"},
+	"versions_default-cr":      {"0.0.17"},
+	"versions_system-settings": {"0.0.17"},
+	"versions_vault-key":       {"0.0.17"},
+}
+
+// Capabilities - the features the API server supports, each with the
+// version of the response it returns, ie: {"features": {"get_default-cr": 2}}
+type Capabilities struct {
+	Features map[string]int `json:"features"`
+	Source   string         `json:"-"`
+}
+
+// Supports - the server supports feature
+func (c *Capabilities) Supports(feature string) bool {
+	return c.Features[feature] > 0
+}
+
+// ResponseVersion - the version of the response the server returns for
+// feature, 0 when it isn't supported
+func (c *Capabilities) ResponseVersion(feature string) int {
+	return c.Features[feature]
+}
+
+// Table - the table output of the features and their response versions
+func (c *Capabilities) Table() printer.Table {
+	names := make([]string, 0, len(c.Features))
+	for name := range c.Features {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := printer.NewTable("FEATURE", "RESPONSE_VERSION")
+	for _, name := range names {
+		table.AddRow(name, strconv.Itoa(c.Features[name]))
+	}
+	return table
+}
+
+// StaticCapabilities - the capabilities of a server without the capabilities
+// endpoint, worked out from its version with CommandVersions and
+// ResponseVersions.  A server version that can't be parsed supports nothing.
+func StaticCapabilities(serverSemVer string) Capabilities {
+	capabilities := Capabilities{Features: map[string]int{}, Source: CapabilitiesStatic}
+	sv, err := semver.Parse(strings.Replace(serverSemVer, "v", "", 1))
+	if err != nil {
+		return capabilities
+	}
+	for feature, minimum := range CommandVersions {
+		if cv, err := semver.Parse(minimum); err != nil || sv.LT(cv) {
+			continue
+		}
+		capabilities.Features[feature] = 1
+		for _, introduced := range ResponseVersions[feature] {
+			if rv, err := semver.Parse(introduced); err != nil || sv.LT(rv) {
+				break
+			}
+			capabilities.Features[feature]++
+		}
+	}
+	return capabilities
+}
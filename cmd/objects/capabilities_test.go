@@ -0,0 +1,30 @@
+package objects
+
+import "testing"
+
+func TestStaticCapabilities(t *testing.T) {
+	tests := []struct {
+		server   string
+		feature  string
+		expected int
+	}{
+		{"v0.0.14", "get_default-cr", 1},
+		{"v0.0.16", "get_default-cr", 1},
+		{"v0.0.17", "get_default-cr", 2},
+		{"v0.1.7", "get_default-cr", 2},
+		{"v0.0.16", "apply_image-tag", 1},
+		{"v0.1.6", "pause", 0},
+		{"v0.1.7", "pause", 1},
+		{"v0.0.14", "rollback_default-cr", 0},
+		{"", "get_default-cr", 0},
+	}
+	for _, tt := range tests {
+		capabilities := StaticCapabilities(tt.server)
+		if got := capabilities.ResponseVersion(tt.feature); got != tt.expected {
+			t.Errorf("%s %s: expected response version %d, got: %d", tt.server, tt.feature, tt.expected, got)
+		}
+		if capabilities.Source != CapabilitiesStatic {
+			t.Errorf("expected static capabilities, got: %s", capabilities.Source)
+		}
+	}
+}
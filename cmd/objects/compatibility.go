@@ -0,0 +1,86 @@
+package objects
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/splicemachine/splicectl/printer"
+)
+
+// CriticalCommands - the commands splicectl isn't useful without, version
+// --check fails when the server doesn't support one of them
+var CriticalCommands = map[string]bool{
+	"apply_database-cr": true,
+	"apply_default-cr":  true,
+	"get_database-cr":   true,
+	"get_default-cr":    true,
+	"list_database":     true,
+}
+
+// CommandCompatibility - whether the server supports a command, and the
+// server version the command needs
+type CommandCompatibility struct {
+	Command         string `json:"command"`
+	Supported       bool   `json:"supported"`
+	Critical        bool   `json:"critical"`
+	RequiredVersion string `json:"required_version"`
+	ResponseVersion int    `json:"response_version,omitempty"`
+}
+
+// CompatibilityReport - the commands of CommandVersions the server supports
+type CompatibilityReport struct {
+	Client   string                 `json:"client"`
+	Server   string                 `json:"server"`
+	Source   string                 `json:"source"`
+	Commands []CommandCompatibility `json:"commands"`
+}
+
+// NewCompatibilityReport - check every command of CommandVersions against the
+// capabilities of the server, sorted by command
+func NewCompatibilityReport(version Version, capabilities Capabilities) CompatibilityReport {
+	report := CompatibilityReport{
+		Client: version.VersionInfo.Client.SemVer,
+		Server: version.VersionInfo.Server.SemVer,
+		Source: capabilities.Source,
+	}
+	for command, required := range CommandVersions {
+		report.Commands = append(report.Commands, CommandCompatibility{
+			Command:         command,
+			Supported:       capabilities.Supports(command),
+			Critical:        CriticalCommands[command],
+			RequiredVersion: "v" + required,
+			ResponseVersion: capabilities.ResponseVersion(command),
+		})
+	}
+	sort.Slice(report.Commands, func(i, j int) bool {
+		return report.Commands[i].Command < report.Commands[j].Command
+	})
+	return report
+}
+
+// MissingCritical - the critical commands the server doesn't support
+func (r *CompatibilityReport) MissingCritical() []string {
+	var missing []string
+	for _, command := range r.Commands {
+		if command.Critical && !command.Supported {
+			missing = append(missing, command.Command)
+		}
+	}
+	return missing
+}
+
+// Table - the table output of the compatibility of each command
+func (r *CompatibilityReport) Table() printer.Table {
+	table := printer.NewTable("COMMAND", "STATUS", "REQUIRED_SERVER", "RESPONSE_VERSION", "CRITICAL")
+	for _, command := range r.Commands {
+		status := "unsupported"
+		response := ""
+		if command.Supported {
+			status = "supported"
+			response = strconv.Itoa(command.ResponseVersion)
+		}
+		table.AddRow(command.Command, status, command.RequiredVersion, response, fmt.Sprintf("%t", command.Critical))
+	}
+	return table
+}
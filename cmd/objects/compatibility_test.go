@@ -0,0 +1,33 @@
+package objects
+
+import "testing"
+
+func TestCompatibilityReport(t *testing.T) {
+	version := Version{}
+	version.VersionInfo.Server.SemVer = "v0.1.6"
+	report := NewCompatibilityReport(version, StaticCapabilities("v0.1.6"))
+
+	if len(report.Commands) != len(CommandVersions) {
+		t.Fatalf("expected every command, got: %d", len(report.Commands))
+	}
+	for _, command := range report.Commands {
+		switch command.Command {
+		case "pause":
+			if command.Supported || command.RequiredVersion != "v0.1.7" {
+				t.Fatalf("expected pause to need v0.1.7, got: %+v", command)
+			}
+		case "get_default-cr":
+			if !command.Supported || !command.Critical || command.ResponseVersion != 2 {
+				t.Fatalf("expected a supported critical command, got: %+v", command)
+			}
+		}
+	}
+	if missing := report.MissingCritical(); len(missing) != 0 {
+		t.Fatalf("expected no missing critical commands, got: %v", missing)
+	}
+
+	old := NewCompatibilityReport(version, StaticCapabilities("v0.0.13"))
+	if missing := old.MissingCritical(); len(missing) != len(CriticalCommands) {
+		t.Fatalf("expected every critical command to be missing, got: %v", missing)
+	}
+}
@@ -0,0 +1,76 @@
+package objects
+
+import "github.com/splicemachine/splicectl/printer"
+
+// ContextList - the named clusters stored in the splicectl config file
+type ContextList struct {
+	Current  string    `json:"current-context" yaml:"current-context"`
+	Contexts []Context `json:"contexts" yaml:"contexts"`
+}
+
+// Context - the API server, CA bundle, Kubernetes context and session used
+// for one cluster.  CACert and Kubeconfig are paths, SpliceNamespace is the
+// namespace splicectl-api is installed in.
+type Context struct {
+	Name            string `json:"name" yaml:"name" mapstructure:"name"`
+	Server          string `json:"server,omitempty" yaml:"server,omitempty" mapstructure:"server"`
+	CACert          string `json:"cacert,omitempty" yaml:"cacert,omitempty" mapstructure:"cacert"`
+	Kubeconfig      string `json:"kubeconfig,omitempty" yaml:"kubeconfig,omitempty" mapstructure:"kubeconfig"`
+	KubeContext     string `json:"kube-context,omitempty" yaml:"kube-context,omitempty" mapstructure:"kube-context"`
+	SpliceNamespace string `json:"splice-namespace,omitempty" yaml:"splice-namespace,omitempty" mapstructure:"splice-namespace"`
+	SessionID       string `json:"session_id,omitempty" yaml:"session_id,omitempty" mapstructure:"session_id"`
+	ValidUntil      string `json:"valid_until,omitempty" yaml:"valid_until,omitempty" mapstructure:"valid_until"`
+}
+
+// Find - the context called name, nil when there isn't one
+func (contextList *ContextList) Find(name string) *Context {
+	for i := range contextList.Contexts {
+		if contextList.Contexts[i].Name == name {
+			return &contextList.Contexts[i]
+		}
+	}
+	return nil
+}
+
+// Set - add the context, replacing the one with the same name
+func (contextList *ContextList) Set(context Context) {
+	if existing := contextList.Find(context.Name); existing != nil {
+		*existing = context
+		return
+	}
+	contextList.Contexts = append(contextList.Contexts, context)
+}
+
+// Delete - remove the context called name, returns false when there wasn't
+// one.  Deleting the current context leaves no context selected.
+func (contextList *ContextList) Delete(name string) bool {
+	for i, context := range contextList.Contexts {
+		if context.Name == name {
+			contextList.Contexts = append(contextList.Contexts[:i], contextList.Contexts[i+1:]...)
+			if contextList.Current == name {
+				contextList.Current = ""
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Items - the contexts in the list, one row each for custom-columns
+func (contextList *ContextList) Items() interface{} {
+	return contextList.Contexts
+}
+
+// Table - the table output of the context list, the current context is
+// marked with a *
+func (contextList *ContextList) Table() printer.Table {
+	table := printer.NewTable("CURRENT", "NAME", "SERVER", "KUBE-CONTEXT", "NAMESPACE", "SESSION-VALID-UNTIL")
+	for _, c := range contextList.Contexts {
+		current := ""
+		if c.Name == contextList.Current {
+			current = "*"
+		}
+		table.AddRow(current, c.Name, c.Server, c.KubeContext, c.SpliceNamespace, c.ValidUntil)
+	}
+	return table
+}
@@ -1,17 +1,12 @@
 package objects
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
-	"strings"
 
-	"github.com/maahsome/gron"
-	"github.com/olekukonko/tablewriter"
-	"github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v2"
+	"github.com/splicemachine/splicectl/printer"
 )
 
 type DatabaseCR struct {
@@ -65,96 +60,15 @@ type DatabaseCRList struct {
 	} `json:"data"`
 }
 
-// ToJSON - Write the output as JSON
-func (cr *DatabaseCR) ToJSON(file string) error {
-
-	crJSON, enverr := json.MarshalIndent(cr, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	} else {
-		if len(file) == 0 {
-			fmt.Println(string(crJSON[:]))
-		} else {
-			WriteToFile(file, string(crJSON[:]))
-		}
-	}
-
-	return nil
-
-}
-
-// ToGRON - Write output in GRON format
-func (cr *DatabaseCR) ToGRON(file string) error {
-
-	crJSON, enverr := json.MarshalIndent(cr, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-
-	subReader := strings.NewReader(string(crJSON[:]))
-	subValues := &bytes.Buffer{}
-	ges := gron.NewGron(subReader, subValues)
-	ges.SetMonochrome(false)
-	serr := ges.ToGron()
-	if serr != nil {
-		logrus.Error("Problem generating gron syntax", serr)
-		return serr
-	}
-	fmt.Println(string(subValues.Bytes()))
-
-	return nil
-}
-
-// ToYAML - Write the output as YAML
-func (cr *DatabaseCR) ToYAML(file string) error {
-
-	crYAML, enverr := yaml.Marshal(cr)
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting yaml")
-		return enverr
-	} else {
-		if len(file) == 0 {
-			fmt.Println(string(crYAML[:]))
-		} else {
-			WriteToFile(file, string(crYAML[:]))
-		}
-	}
-	return nil
-
-}
-
-// ToTEXT - Write the output as TEXT
-func (cr *DatabaseCR) ToTEXT(noHeaders bool) error {
-
-	var row []string
-
-	out, _ := json.Marshal(&cr)
-
+// Table - the table output of the workspace CR, shows the enabled components
+func (cr *DatabaseCR) Table() printer.Table {
 	var crList DatabaseCRList
-	marshErr := json.Unmarshal([]byte(out), &crList)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
+	// The CR was decoded from JSON, the round trip can't fail
+	out, _ := json.Marshal(cr)
+	_ = json.Unmarshal(out, &crList)
 
-	// ******************** TableWriter *******************************
-	table := tablewriter.NewWriter(os.Stdout)
-	if !noHeaders {
-		table.SetHeader([]string{"DATABASE", "NAMESPACE", "HAPROXY", "HBASE", "HDFS", "JUPYTERHUB", "JVMPROFILER", "KAFKA", "MLMANAGER", "RBAC", "SPLICE-HTTP", "ZOOKEEPER"})
-		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
-	}
-	table.SetAutoWrapText(false)
-	table.SetAutoFormatHeaders(true)
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetCenterSeparator("")
-	table.SetColumnSeparator("")
-	table.SetRowSeparator("")
-	table.SetHeaderLine(false)
-	table.SetBorder(false)
-	table.SetTablePadding("\t") // pad with tabs
-	table.SetNoWhiteSpace(true)
-	row = []string{crList.Data.Metadata.Name,
+	table := printer.NewTable("DATABASE", "NAMESPACE", "HAPROXY", "HBASE", "HDFS", "JUPYTERHUB", "JVMPROFILER", "KAFKA", "MLMANAGER", "RBAC", "SPLICE-HTTP", "ZOOKEEPER")
+	table.AddRow(crList.Data.Metadata.Name,
 		crList.Data.Spec.Global.Namespace,
 		fmt.Sprintf("%t", crList.Data.Spec.Condition.Haproxy.Enabled),
 		fmt.Sprintf("%t", crList.Data.Spec.Condition.Hbase.Enabled),
@@ -166,12 +80,8 @@ func (cr *DatabaseCR) ToTEXT(noHeaders bool) error {
 		fmt.Sprintf("%t", crList.Data.Spec.Condition.Rbac.Enabled),
 		fmt.Sprintf("%t", crList.Data.Spec.Condition.SpliceHTTP.Enabled),
 		fmt.Sprintf("%t", crList.Data.Spec.Condition.Zookeeper.Enabled),
-	}
-	table.Append(row)
-	table.Render()
-
-	return nil
-
+	)
+	return table
 }
 
 // WriteToFile will print any string of text to a file safely by
@@ -1,16 +1,14 @@
 package objects
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
-	"github.com/maahsome/gron"
-	"github.com/olekukonko/tablewriter"
-	"github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v2"
+	"github.com/splicemachine/splicectl/printer"
 )
 
 // DatabaseList - Data to read from the CM API
@@ -58,9 +56,16 @@ type CMUser struct {
 	Email     string `json:"email"`
 }
 
+// The statuses of a workspace waited for by --wait
+const (
+	StatusActive = "Active"
+	StatusPaused = "Paused"
+)
+
 const (
 	active = "active"
 	paused = "paused"
+	failed = "failed"
 )
 
 // Active - returns whether the cluster described is active or not
@@ -68,6 +73,37 @@ func (cmci CMClusterInfo) Active() bool {
 	return strings.ToLower(cmci.Status) == active
 }
 
+// Paused - returns whether the cluster described is paused or not
+func (cmci CMClusterInfo) Paused() bool {
+	return strings.ToLower(cmci.Status) == paused
+}
+
+// Failed - returns whether the cluster described failed to reach its state
+func (cmci CMClusterInfo) Failed() bool {
+	status := strings.ToLower(cmci.Status)
+	return status == failed || status == "error"
+}
+
+// FreeTier - the current configuration of the cluster is free tier
+func (cmci CMClusterInfo) FreeTier() bool {
+	for _, config := range cmci.ClusterConfigurations {
+		if len(config.EffectiveEndDate) == 0 && config.FreeTier {
+			return true
+		}
+	}
+	return false
+}
+
+// Find - the workspace called name, by its DCOS app id or its name
+func (dbl *DatabaseList) Find(name string) (CMClusterInfo, bool) {
+	for _, clusterInfo := range dbl.Clusters {
+		if clusterInfo.DcosAppId == name || clusterInfo.Name == name {
+			return clusterInfo, true
+		}
+	}
+	return CMClusterInfo{}, false
+}
+
 // GroupBy - filters the list on a given test
 func (dbl *DatabaseList) GroupBy(test func(CMClusterInfo) bool) *DatabaseList {
 	newDbl := make([]CMClusterInfo, 0)
@@ -94,85 +130,142 @@ func (dbl *DatabaseList) FilterByStatus(active, paused bool) *DatabaseList {
 	}
 }
 
-// ToJSON - Write the output as JSON
-func (databaseList *DatabaseList) ToJSON() error {
-
-	dblJSON, enverr := json.MarshalIndent(databaseList, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	} else {
-		fmt.Println(string(dblJSON[:]))
-	}
+// WorkspaceFilter - the filters of list workspace, the zero value of a field
+// doesn't filter
+type WorkspaceFilter struct {
+	// Account - glob pattern of the account name or id
+	Account string
+	// Owner - glob pattern of the email of the user
+	Owner         string
+	Name          *regexp.Regexp
+	CreatedBefore time.Time
+	CreatedAfter  time.Time
+	FreeTier      *bool
+}
 
-	return nil
+// Created - the time the cluster was created, false when it can't be parsed
+func (cmci CMClusterInfo) Created() (time.Time, bool) {
+	created, err := time.Parse(time.RFC3339, cmci.CreatedAt)
+	return created, err == nil
+}
 
+// Updated - the time the cluster was last updated, false when it can't be
+// parsed
+func (cmci CMClusterInfo) Updated() (time.Time, bool) {
+	updated, err := time.Parse(time.RFC3339, cmci.UpdatedAt)
+	return updated, err == nil
 }
 
-// ToGRON - Write the output as GRON
-func (databaseList *DatabaseList) ToGRON() error {
-	dbJSON, enverr := json.MarshalIndent(databaseList, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
+// Filter - the workspaces that pass every filter, in the initial order
+func (dbl *DatabaseList) Filter(filter WorkspaceFilter) *DatabaseList {
+	filtered := dbl
+	if len(filter.Account) > 0 {
+		filtered = filtered.GroupBy(func(cmci CMClusterInfo) bool {
+			return MatchPattern(filter.Account, cmci.Account.AccountName) || MatchPattern(filter.Account, cmci.Account.AccountId)
+		})
 	}
-
-	subReader := strings.NewReader(string(dbJSON[:]))
-	subValues := &bytes.Buffer{}
-	ges := gron.NewGron(subReader, subValues)
-	ges.SetMonochrome(false)
-	serr := ges.ToGron()
-	if serr != nil {
-		logrus.Error("Problem generating gron syntax", serr)
-		return serr
+	if len(filter.Owner) > 0 {
+		filtered = filtered.GroupBy(func(cmci CMClusterInfo) bool { return MatchPattern(filter.Owner, cmci.User.Email) })
 	}
-	fmt.Println(string(subValues.Bytes()))
+	if filter.Name != nil {
+		filtered = filtered.GroupBy(func(cmci CMClusterInfo) bool {
+			return filter.Name.MatchString(cmci.DcosAppId) || filter.Name.MatchString(cmci.Name)
+		})
+	}
+	if !filter.CreatedBefore.IsZero() {
+		filtered = filtered.GroupBy(func(cmci CMClusterInfo) bool {
+			created, ok := cmci.Created()
+			return ok && created.Before(filter.CreatedBefore)
+		})
+	}
+	if !filter.CreatedAfter.IsZero() {
+		filtered = filtered.GroupBy(func(cmci CMClusterInfo) bool {
+			created, ok := cmci.Created()
+			return ok && created.After(filter.CreatedAfter)
+		})
+	}
+	if filter.FreeTier != nil {
+		filtered = filtered.GroupBy(func(cmci CMClusterInfo) bool { return cmci.FreeTier() == *filter.FreeTier })
+	}
+	return filtered
+}
 
-	return nil
+// workspaceSortKeys - the fields list workspace can sort by
+var workspaceSortKeys = map[string]func(CMClusterInfo) string{
+	"name":      func(cmci CMClusterInfo) string { return cmci.DcosAppId },
+	"namespace": func(cmci CMClusterInfo) string { return cmci.Namespace },
+	"status":    func(cmci CMClusterInfo) string { return cmci.Status },
+	"account":   func(cmci CMClusterInfo) string { return cmci.Account.AccountName },
+	"owner":     func(cmci CMClusterInfo) string { return cmci.User.Email },
+}
 
+// workspaceTimeSortKeys - the time fields list workspace can sort by
+var workspaceTimeSortKeys = map[string]func(CMClusterInfo) (time.Time, bool){
+	"created": CMClusterInfo.Created,
+	"updated": CMClusterInfo.Updated,
 }
 
-// ToYAML - Write the output as YAML
-func (databaseList *DatabaseList) ToYAML() error {
+// WorkspaceSortFields - the fields list workspace can sort by
+func WorkspaceSortFields() []string {
+	fields := make([]string, 0, len(workspaceSortKeys)+len(workspaceTimeSortKeys))
+	for field := range workspaceSortKeys {
+		fields = append(fields, field)
+	}
+	for field := range workspaceTimeSortKeys {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
 
-	dblYAML, enverr := yaml.Marshal(databaseList)
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting yaml")
-		return enverr
-	} else {
-		fmt.Println(string(dblYAML[:]))
+// ValidateWorkspaceSort - check list workspace can sort by field
+func ValidateWorkspaceSort(field string) error {
+	field = strings.ToLower(field)
+	if _, ok := workspaceSortKeys[field]; ok {
+		return nil
+	}
+	if _, ok := workspaceTimeSortKeys[field]; !ok {
+		return fmt.Errorf("unknown sort field %q, expected one of: %s", field, strings.Join(WorkspaceSortFields(), "|"))
 	}
 	return nil
-
 }
 
-// ToTEXT - Write the output as TEXT
-func (databaseList *DatabaseList) ToTEXT(noHeaders bool) error {
-
-	var row []string
-
-	// ******************** TableWriter *******************************
-	table := tablewriter.NewWriter(os.Stdout)
-	if !noHeaders {
-		table.SetHeader([]string{"DATABASE", "NAMESPACE", "STATUS", "CLUSTER_ID"})
-		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+// SortBy - sort the list on field, see WorkspaceSortFields.  The times are
+// compared once parsed, the ones that can't be parsed sort last.
+func (dbl *DatabaseList) SortBy(field string) error {
+	if err := ValidateWorkspaceSort(field); err != nil {
+		return err
 	}
-	table.SetAutoWrapText(false)
-	table.SetAutoFormatHeaders(true)
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetCenterSeparator("")
-	table.SetColumnSeparator("")
-	table.SetRowSeparator("")
-	table.SetHeaderLine(false)
-	table.SetBorder(false)
-	table.SetTablePadding("\t") // pad with tabs
-	table.SetNoWhiteSpace(true)
-	for _, v := range databaseList.Clusters {
-		row = []string{v.DcosAppId, v.Namespace, v.Status, v.ClusterId}
-		table.Append(row)
+	if timeKey, ok := workspaceTimeSortKeys[strings.ToLower(field)]; ok {
+		sort.SliceStable(dbl.Clusters, func(i, j int) bool {
+			ti, iok := timeKey(dbl.Clusters[i])
+			tj, jok := timeKey(dbl.Clusters[j])
+			if iok && jok {
+				return ti.Before(tj)
+			}
+			return iok && !jok
+		})
+		return nil
 	}
-	table.Render()
-
+	key := workspaceSortKeys[strings.ToLower(field)]
+	sort.SliceStable(dbl.Clusters, func(i, j int) bool {
+		return strings.ToLower(key(dbl.Clusters[i])) < strings.ToLower(key(dbl.Clusters[j]))
+	})
 	return nil
+}
+
+// Items - the workspaces in the list, one row each for custom-columns
+func (databaseList *DatabaseList) Items() interface{} {
+	return databaseList.Clusters
+}
 
+// Table - the table output of the workspace list
+func (databaseList *DatabaseList) Table() printer.Table {
+	table := printer.NewTable("DATABASE", "NAMESPACE", "STATUS", "CLUSTER_ID")
+	table.AddWideColumns("ACCOUNT", "OWNER", "CREATED", "UPDATED", "FREE_TIER")
+	for _, v := range databaseList.Clusters {
+		table.AddRow(v.DcosAppId, v.Namespace, v.Status, v.ClusterId,
+			v.Account.AccountName, v.User.Email, v.CreatedAt, v.UpdatedAt, strconv.FormatBool(v.FreeTier()))
+	}
+	return table
 }
@@ -0,0 +1,72 @@
+package objects
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func testDatabaseList() *DatabaseList {
+	return &DatabaseList{Clusters: []CMClusterInfo{
+		{DcosAppId: "splicedb", CreatedAt: "2021-03-01T00:00:00Z", Account: CMAccount{AccountName: "Sales"}, User: CMUser{Email: "b@example.com"}},
+		{DcosAppId: "dev-one", CreatedAt: "2020-06-01T00:00:00Z", Account: CMAccount{AccountName: "Engineering"}, User: CMUser{Email: "a@example.com"},
+			ClusterConfigurations: []CMClusterConfiguration{{FreeTier: true}}},
+		{DcosAppId: "dev-two", CreatedAt: "not a time", Account: CMAccount{AccountName: "Engineering"}, User: CMUser{Email: "c@example.com"}},
+	}}
+}
+
+func names(dbl *DatabaseList) string {
+	var result []string
+	for _, cmci := range dbl.Clusters {
+		result = append(result, cmci.DcosAppId)
+	}
+	return fmt.Sprint(result)
+}
+
+func TestDatabaseListFilter(t *testing.T) {
+	freeTier := true
+	tests := []struct {
+		name     string
+		filter   WorkspaceFilter
+		expected string
+	}{
+		{"none", WorkspaceFilter{}, "[splicedb dev-one dev-two]"},
+		{"account", WorkspaceFilter{Account: "engineering"}, "[dev-one dev-two]"},
+		{"owner", WorkspaceFilter{Owner: "b@*"}, "[splicedb]"},
+		{"name", WorkspaceFilter{Name: regexp.MustCompile("^dev-")}, "[dev-one dev-two]"},
+		{"created before", WorkspaceFilter{CreatedBefore: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}, "[dev-one]"},
+		{"created after", WorkspaceFilter{CreatedAfter: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}, "[splicedb]"},
+		{"free tier", WorkspaceFilter{FreeTier: &freeTier}, "[dev-one]"},
+		{"combined", WorkspaceFilter{Account: "engineering", Owner: "c@*"}, "[dev-two]"},
+	}
+	for _, tt := range tests {
+		if got := names(testDatabaseList().Filter(tt.filter)); got != tt.expected {
+			t.Errorf("%s: expected %s, got: %s", tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestDatabaseListSortBy(t *testing.T) {
+	dbl := testDatabaseList()
+	if err := dbl.SortBy("owner"); err != nil || names(dbl) != "[dev-one splicedb dev-two]" {
+		t.Fatalf("expected the list sorted by owner, got: %s %v", names(dbl), err)
+	}
+	if err := dbl.SortBy("NAME"); err != nil || names(dbl) != "[dev-one dev-two splicedb]" {
+		t.Fatalf("expected the list sorted by name, got: %s %v", names(dbl), err)
+	}
+	if err := dbl.SortBy("created"); err != nil || names(dbl) != "[dev-one splicedb dev-two]" {
+		t.Fatalf("expected the list sorted by creation with the invalid time last, got: %s %v", names(dbl), err)
+	}
+	mixed := &DatabaseList{Clusters: []CMClusterInfo{
+		{DcosAppId: "utc", CreatedAt: "2021-03-01T10:00:00Z"},
+		{DcosAppId: "offset", CreatedAt: "2021-03-01T11:00:00+02:00"},
+		{DcosAppId: "fraction", CreatedAt: "2021-03-01T10:00:00.5Z"},
+	}}
+	if err := mixed.SortBy("created"); err != nil || names(mixed) != "[offset utc fraction]" {
+		t.Fatalf("expected the times compared once parsed, got: %s %v", names(mixed), err)
+	}
+	if err := dbl.SortBy("size"); err == nil {
+		t.Fatalf("expected an error for an unknown sort field")
+	}
+}
@@ -1,13 +1,5 @@
 package objects
 
-import (
-	"encoding/json"
-	"fmt"
-
-	"github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v2"
-)
-
 // DatabaseRequest - Cloud Manager Database Request
 type DatabaseRequest struct {
 	AccountID                    string `json:"accountId"`
@@ -31,31 +23,3 @@ type DatabaseRequest struct {
 	Password                     string `json:"password"`
 	// Region                       string `json:"region"`
 }
-
-// ToJSON - Write the output as JSON
-func (r *DatabaseRequest) ToJSON() error {
-
-	rJSON, enverr := json.MarshalIndent(r, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-	fmt.Println(string(rJSON[:]))
-
-	return nil
-
-}
-
-// ToYAML - Write the output as YAML
-func (r *DatabaseRequest) ToYAML() error {
-
-	rYAML, enverr := yaml.Marshal(r)
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting yaml")
-		return enverr
-	}
-	fmt.Println(string(rYAML[:]))
-
-	return nil
-
-}
@@ -0,0 +1,164 @@
+package objects
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/splicemachine/splicectl/printer"
+)
+
+// DatabaseComponents - the components of a workspace, the same components
+// apply image-tag accepts
+var DatabaseComponents = []string{"allspark", "hbase", "hdfs", "kafka", "zookeeper"}
+
+// The readiness of a component in the table output
+const (
+	ComponentReady    = "Ready"
+	ComponentNotReady = "NotReady"
+	ComponentMissing  = "Missing"
+)
+
+// DatabaseStatus - the readiness of the components of a workspace
+type DatabaseStatus struct {
+	Database   string            `json:"database"`
+	Status     string            `json:"status"`
+	Components []ComponentStatus `json:"components"`
+}
+
+// UnmarshalJSON - decode the status, a response without components isn't a
+// database status rather than a database whose components are all missing
+func (ds *DatabaseStatus) UnmarshalJSON(data []byte) error {
+	type databaseStatus DatabaseStatus
+	var status struct {
+		databaseStatus
+		Components *[]ComponentStatus `json:"components"`
+	}
+	if err := json.Unmarshal(data, &status); err != nil {
+		return err
+	}
+	if status.Components == nil {
+		return errors.New("the database status has no components")
+	}
+	*ds = DatabaseStatus(status.databaseStatus)
+	ds.Components = *status.Components
+	return nil
+}
+
+// ComponentStatus - the readiness of the pods of a workspace component
+type ComponentStatus struct {
+	Component string      `json:"component"`
+	Pods      []PodStatus `json:"pods"`
+}
+
+// PodStatus - the readiness of one pod of a component
+type PodStatus struct {
+	Name     string `json:"name"`
+	Phase    string `json:"phase"`
+	Ready    bool   `json:"ready"`
+	Restarts int    `json:"restarts"`
+}
+
+// ReadyPods - the number of pods of the component that are ready
+func (c ComponentStatus) ReadyPods() int {
+	ready := 0
+	for _, pod := range c.Pods {
+		if pod.Ready {
+			ready++
+		}
+	}
+	return ready
+}
+
+// Restarts - the restarts of every pod of the component
+func (c ComponentStatus) Restarts() int {
+	restarts := 0
+	for _, pod := range c.Pods {
+		restarts += pod.Restarts
+	}
+	return restarts
+}
+
+// State - Ready when the component has pods and all are ready, Missing when
+// it has none
+func (c ComponentStatus) State() string {
+	switch {
+	case len(c.Pods) == 0:
+		return ComponentMissing
+	case c.ReadyPods() < len(c.Pods):
+		return ComponentNotReady
+	}
+	return ComponentReady
+}
+
+// Component - the status of the named component, a component without pods
+// when the server didn't report it
+func (ds *DatabaseStatus) Component(name string) ComponentStatus {
+	for _, component := range ds.Components {
+		if strings.EqualFold(component.Component, name) {
+			return component
+		}
+	}
+	return ComponentStatus{Component: name}
+}
+
+// NotReady - the components of the workspace that aren't ready, including
+// those of DatabaseComponents the server didn't report
+func (ds *DatabaseStatus) NotReady() []string {
+	var notReady []string
+	for _, component := range ds.components() {
+		if component.State() != ComponentReady {
+			notReady = append(notReady, component.Component)
+		}
+	}
+	return notReady
+}
+
+// Ready - every component of the workspace is ready
+func (ds *DatabaseStatus) Ready() bool {
+	return len(ds.NotReady()) == 0
+}
+
+// components - every component of DatabaseComponents followed by any other
+// component the server reported, sorted by name
+func (ds *DatabaseStatus) components() []ComponentStatus {
+	known := map[string]bool{}
+	var components []ComponentStatus
+	for _, name := range DatabaseComponents {
+		known[name] = true
+		components = append(components, ds.Component(name))
+	}
+	var others []ComponentStatus
+	for _, component := range ds.Components {
+		if !known[strings.ToLower(component.Component)] {
+			others = append(others, component)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].Component < others[j].Component
+	})
+	return append(components, others...)
+}
+
+// Items - the components of the workspace, one row each for custom-columns
+func (ds *DatabaseStatus) Items() interface{} {
+	return ds.Components
+}
+
+// Table - the table output of the readiness of each component
+func (ds *DatabaseStatus) Table() printer.Table {
+	table := printer.NewTable("COMPONENT", "READY", "STATUS", "RESTARTS")
+	table.AddWideColumns("PODS")
+	for _, component := range ds.components() {
+		pods := make([]string, len(component.Pods))
+		for i, pod := range component.Pods {
+			pods[i] = pod.Name
+		}
+		table.AddRow(component.Component, fmt.Sprintf("%d/%d", component.ReadyPods(), len(component.Pods)),
+			component.State(), strconv.Itoa(component.Restarts()), strings.Join(pods, ","))
+	}
+	return table
+}
@@ -0,0 +1,62 @@
+package objects
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDatabaseStatus(t *testing.T) {
+	in := `{"database":"splicedb","status":"Active","components":[
+		{"component":"hbase","pods":[{"name":"hbase-0","phase":"Running","ready":true,"restarts":1},{"name":"hbase-1","phase":"Pending","ready":false}]},
+		{"component":"hdfs","pods":[{"name":"hdfs-0","phase":"Running","ready":true}]},
+		{"component":"kafka","pods":[{"name":"kafka-0","phase":"Running","ready":true}]},
+		{"component":"zookeeper","pods":[{"name":"zookeeper-0","phase":"Running","ready":true,"restarts":2}]},
+		{"component":"jupyter","pods":[{"name":"jupyter-0","phase":"Running","ready":true}]}]}`
+	var status DatabaseStatus
+	if err := json.Unmarshal([]byte(in), &status); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	notReady := status.NotReady()
+	if len(notReady) != 2 || notReady[0] != "allspark" || notReady[1] != "hbase" {
+		t.Fatalf("expected allspark and hbase not ready, got: %v", notReady)
+	}
+	if status.Ready() {
+		t.Fatal("expected the database not to be ready")
+	}
+
+	table := status.Table()
+	if len(table.Rows) != len(DatabaseComponents)+1 {
+		t.Fatalf("expected a row per component, got: %v", table.Rows)
+	}
+	want := map[string][]string{
+		"allspark":  {"allspark", "0/0", ComponentMissing, "0", ""},
+		"hbase":     {"hbase", "1/2", ComponentNotReady, "1", "hbase-0,hbase-1"},
+		"zookeeper": {"zookeeper", "1/1", ComponentReady, "2", "zookeeper-0"},
+		"jupyter":   {"jupyter", "1/1", ComponentReady, "0", "jupyter-0"},
+	}
+	for _, row := range table.Rows {
+		expected, ok := want[row[0]]
+		if !ok {
+			continue
+		}
+		for i := range expected {
+			if row[i] != expected[i] {
+				t.Fatalf("expected row %v, got: %v", expected, row)
+			}
+		}
+	}
+	if last := table.Rows[len(table.Rows)-1]; last[0] != "jupyter" {
+		t.Fatalf("expected the unknown components last, got: %v", last)
+	}
+}
+
+func TestDatabaseStatusWithoutComponents(t *testing.T) {
+	var status DatabaseStatus
+	if err := json.Unmarshal([]byte(`{"database":"splicedb","pods":[]}`), &status); err == nil {
+		t.Fatalf("expected a response without components to be an error, got: %+v", status)
+	}
+	if err := json.Unmarshal([]byte(`{"database":"splicedb","components":[]}`), &status); err != nil || status.Database != "splicedb" {
+		t.Fatalf("expected the status of splicedb, got: %+v %v", status, err)
+	}
+}
@@ -1,17 +1,6 @@
 package objects
 
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"os"
-	"strings"
-
-	"github.com/maahsome/gron"
-	"github.com/olekukonko/tablewriter"
-	"github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v2"
-)
+import "github.com/splicemachine/splicectl/printer"
 
 // ImageTagList - An array of image tags
 type ImageTagList struct {
@@ -25,84 +14,16 @@ type ImageTag struct {
 	ActiveImage     string `json:"ActiveImage"`
 }
 
-// ToJSON - Write the output as JSON
-func (i *ImageTagList) ToJSON() error {
-
-	imageTagJSON, enverr := json.MarshalIndent(i, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-	fmt.Println(string(imageTagJSON[:]))
-
-	return nil
-
-}
-
-// ToGRON - Write the output as GRON
-func (i *ImageTagList) ToGRON() error {
-	tagJSON, enverr := json.MarshalIndent(i, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-
-	subReader := strings.NewReader(string(tagJSON[:]))
-	subValues := &bytes.Buffer{}
-	ges := gron.NewGron(subReader, subValues)
-	ges.SetMonochrome(false)
-	serr := ges.ToGron()
-	if serr != nil {
-		logrus.Error("Problem generating gron syntax", serr)
-		return serr
-	}
-	fmt.Println(string(subValues.Bytes()))
-
-	return nil
-
+// Items - the image tags in the list, one row each for custom-columns
+func (i *ImageTagList) Items() interface{} {
+	return i.ImageTags
 }
 
-// ToYAML - Write the output as YAML
-func (i *ImageTagList) ToYAML() error {
-
-	imageTagYAML, enverr := yaml.Marshal(i)
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting yaml")
-		return enverr
-	}
-	fmt.Println(string(imageTagYAML[:]))
-
-	return nil
-
-}
-
-// ToTEXT - Write the output as TEXT
-func (i *ImageTagList) ToTEXT(noHeaders bool) error {
-
-	var row []string
-
-	// ******************** TableWriter *******************************
-	table := tablewriter.NewWriter(os.Stdout)
-	if !noHeaders {
-		table.SetHeader([]string{"COMPONENT", "DB_CR_IMAGE", "ACTIVE_IMAGE"})
-		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
-	}
-	table.SetAutoWrapText(false)
-	table.SetAutoFormatHeaders(true)
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetCenterSeparator("")
-	table.SetColumnSeparator("")
-	table.SetRowSeparator("")
-	table.SetHeaderLine(false)
-	table.SetBorder(false)
-	table.SetTablePadding("\t") // pad with tabs
-	table.SetNoWhiteSpace(true)
+// Table - the table output of the image tags
+func (i *ImageTagList) Table() printer.Table {
+	table := printer.NewTable("COMPONENT", "DB_CR_IMAGE", "ACTIVE_IMAGE")
 	for _, v := range i.ImageTags {
-		row = []string{v.Component, v.DatabaseCRImage, v.ActiveImage}
-		table.Append(row)
+		table.AddRow(v.Component, v.DatabaseCRImage, v.ActiveImage)
 	}
-	table.Render()
-
-	return nil
-
+	return table
 }
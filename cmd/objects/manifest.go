@@ -0,0 +1,152 @@
+package objects
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/splicemachine/splicectl/printer"
+)
+
+// Manifest kinds, one per resource that can be applied
+const (
+	KindDefaultCR      = "DefaultCR"
+	KindDatabaseCR     = "DatabaseCR"
+	KindSystemSettings = "SystemSettings"
+	KindCMSettings     = "CMSettings"
+	KindVaultKey       = "VaultKey"
+	KindImageTag       = "ImageTag"
+)
+
+// ManifestKinds - the kinds accepted by 'splicectl apply -f'
+var ManifestKinds = []string{
+	KindDefaultCR,
+	KindDatabaseCR,
+	KindSystemSettings,
+	KindCMSettings,
+	KindVaultKey,
+	KindImageTag,
+}
+
+// Manifest - a document applied with 'splicectl apply -f', ie:
+//
+//	kind: DatabaseCR
+//	metadata:
+//	  database-name: splicedb
+//	data:
+//	  ...
+//
+// Everything other than kind and metadata is the body submitted to the API
+// server.
+type Manifest struct {
+	Kind     string                 `json:"kind"`
+	Metadata ManifestMetadata       `json:"metadata"`
+	Body     map[string]interface{} `json:"-"`
+	// Source - the file the manifest was read from
+	Source string `json:"-"`
+}
+
+// ManifestMetadata - identifies the resource a manifest applies to
+type ManifestMetadata struct {
+	DatabaseName string `json:"database-name,omitempty"`
+	KeyPath      string `json:"keypath,omitempty"`
+	Component    string `json:"component,omitempty"`
+	// ExpectedVersion - only apply when the latest vault version matches
+	ExpectedVersion int `json:"expected-version,omitempty"`
+}
+
+// NormalizeKind - return the canonical spelling of kind, kinds are matched
+// without regard to case.
+func NormalizeKind(kind string) (string, error) {
+	for _, k := range ManifestKinds {
+		if strings.EqualFold(k, kind) {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("unknown kind %q, expected one of: %s", kind, strings.Join(ManifestKinds, ", "))
+}
+
+// Name - a short description of the resource the manifest applies to
+func (m *Manifest) Name() string {
+	switch m.Kind {
+	case KindDatabaseCR:
+		return m.Metadata.DatabaseName
+	case KindCMSettings:
+		return m.Metadata.Component
+	case KindVaultKey:
+		return m.Metadata.KeyPath
+	case KindImageTag:
+		return fmt.Sprintf("%s/%s", m.Metadata.DatabaseName, m.Metadata.Component)
+	default:
+		return "-"
+	}
+}
+
+// ApplyResultList - the outcome of applying a set of manifests
+type ApplyResultList struct {
+	Results []ApplyResult `json:"results"`
+}
+
+// ApplyResult - the outcome of applying a single manifest, Version is the
+// vault version created, ImageTag has no version.
+type ApplyResult struct {
+	Source  string `json:"source"`
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Version int    `json:"version,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Items - the results in the list, one row each for custom-columns
+func (arl *ApplyResultList) Items() interface{} {
+	return arl.Results
+}
+
+// Table - the table output of the apply results
+func (arl *ApplyResultList) Table() printer.Table {
+	table := printer.NewTable("SOURCE", "KIND", "NAME", "VERSION", "RESULT")
+	for _, v := range arl.Results {
+		version := "-"
+		if v.Version > 0 {
+			version = fmt.Sprintf("%d", v.Version)
+		}
+		result := "applied"
+		if len(v.Error) > 0 {
+			result = v.Error
+		}
+		table.AddRow(v.Source, v.Kind, v.Name, version, result)
+	}
+	return table
+}
+
+// Validate - check that the metadata required by the kind is present
+func (m *Manifest) Validate() error {
+	switch m.Kind {
+	case KindDatabaseCR:
+		if len(m.Metadata.DatabaseName) == 0 {
+			return fmt.Errorf("%s requires metadata.database-name", m.Kind)
+		}
+	case KindCMSettings:
+		component := strings.ToLower(m.Metadata.Component)
+		if component != "ui" && component != "api" {
+			return fmt.Errorf("%s requires metadata.component to be 'ui' or 'api'", m.Kind)
+		}
+	case KindVaultKey:
+		if len(m.Metadata.KeyPath) == 0 {
+			return fmt.Errorf("%s requires metadata.keypath", m.Kind)
+		}
+	case KindImageTag:
+		if len(m.Metadata.DatabaseName) == 0 || len(m.Metadata.Component) == 0 {
+			return fmt.Errorf("%s requires metadata.database-name and metadata.component", m.Kind)
+		}
+		if tag, ok := m.Body["tag"].(string); !ok || len(tag) == 0 {
+			return fmt.Errorf("%s requires a tag", m.Kind)
+		}
+		if m.Metadata.ExpectedVersion != 0 {
+			return fmt.Errorf("%s is not versioned, metadata.expected-version can not be used", m.Kind)
+		}
+	}
+	if m.Metadata.ExpectedVersion < 0 {
+		return fmt.Errorf("metadata.expected-version must be a positive number")
+	}
+	return nil
+}
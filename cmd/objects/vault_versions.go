@@ -1,16 +1,9 @@
 package objects
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"os"
-	"strings"
 
-	"github.com/maahsome/gron"
-	"github.com/olekukonko/tablewriter"
-	"github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v2"
+	"github.com/splicemachine/splicectl/printer"
 )
 
 // VaultVersionList - array of versions
@@ -26,166 +19,34 @@ type VaultVersion struct {
 	Destroyed    bool   `json:"destroyed"`
 }
 
-// ToJSON - Write the output as JSON
-func (vv *VaultVersionList) ToJSON() error {
-
-	vvJSON, enverr := json.MarshalIndent(vv, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-	fmt.Println(string(vvJSON[:]))
-
-	return nil
-
-}
-
-// ToGRON - Write the output as GRON
-func (vv *VaultVersionList) ToGRON() error {
-	vvJSON, enverr := json.MarshalIndent(vv, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-
-	subReader := strings.NewReader(string(vvJSON[:]))
-	subValues := &bytes.Buffer{}
-	ges := gron.NewGron(subReader, subValues)
-	ges.SetMonochrome(false)
-	serr := ges.ToGron()
-	if serr != nil {
-		logrus.Error("Problem generating gron syntax", serr)
-		return serr
-	}
-	fmt.Println(string(subValues.Bytes()))
-
-	return nil
-
+// Items - the versions in the list, one row each for custom-columns
+func (vv *VaultVersionList) Items() interface{} {
+	return vv.Versions
 }
 
-// ToYAML - Write the output as YAML
-func (vv *VaultVersionList) ToYAML() error {
-
-	vvYAML, enverr := yaml.Marshal(vv)
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting yaml")
-		return enverr
-	}
-	fmt.Println(string(vvYAML[:]))
-
-	return nil
-
-}
-
-// ToTEXT - Write the output as TEXT
-func (vv *VaultVersionList) ToTEXT(noHeaders bool) error {
-
-	var row []string
-
-	// ******************** TableWriter *******************************
-	table := tablewriter.NewWriter(os.Stdout)
-	if !noHeaders {
-		table.SetHeader([]string{"VERSION", "CREATED_AT", "DELETED_AT", "DESTROYED"})
-		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
-	}
-	table.SetAutoWrapText(false)
-	table.SetAutoFormatHeaders(true)
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetCenterSeparator("")
-	table.SetColumnSeparator("")
-	table.SetRowSeparator("")
-	table.SetHeaderLine(false)
-	table.SetBorder(false)
-	table.SetTablePadding("\t") // pad with tabs
-	table.SetNoWhiteSpace(true)
+// Latest - the highest version in the list, 0 when the list is empty
+func (vv *VaultVersionList) Latest() int {
+	latest := 0
 	for _, v := range vv.Versions {
-		row = []string{fmt.Sprintf("%d", v.Version), v.CreatedTime, v.DeletionTime, fmt.Sprintf("%t", v.Destroyed)}
-		table.Append(row)
-	}
-	table.Render()
-
-	return nil
-
-}
-
-// ToJSON - Write the output as JSON
-func (vv *VaultVersion) ToJSON() error {
-
-	vvJSON, enverr := json.MarshalIndent(vv, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-	fmt.Println(string(vvJSON[:]))
-
-	return nil
-
-}
-
-// ToGRON - Write the output as GRON
-func (vv *VaultVersion) ToGRON() error {
-	vvJSON, enverr := json.MarshalIndent(vv, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-
-	subReader := strings.NewReader(string(vvJSON[:]))
-	subValues := &bytes.Buffer{}
-	ges := gron.NewGron(subReader, subValues)
-	ges.SetMonochrome(false)
-	serr := ges.ToGron()
-	if serr != nil {
-		logrus.Error("Problem generating gron syntax", serr)
-		return serr
+		if v.Version > latest {
+			latest = v.Version
+		}
 	}
-	fmt.Println(string(subValues.Bytes()))
-
-	return nil
-
+	return latest
 }
 
-// ToYAML - Write the output as YAML
-func (vv *VaultVersion) ToYAML() error {
-
-	vvYAML, enverr := yaml.Marshal(vv)
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting yaml")
-		return enverr
+// Table - the table output of the version list
+func (vv *VaultVersionList) Table() printer.Table {
+	table := printer.NewTable("VERSION", "CREATED_AT", "DELETED_AT", "DESTROYED")
+	for _, v := range vv.Versions {
+		table.AddRow(fmt.Sprintf("%d", v.Version), v.CreatedTime, v.DeletionTime, fmt.Sprintf("%t", v.Destroyed))
 	}
-	fmt.Println(string(vvYAML[:]))
-
-	return nil
-
+	return table
 }
 
-// ToTEXT - Write the output as TEXT
-func (vv *VaultVersion) ToTEXT(noHeaders bool) error {
-
-	var row []string
-
-	// ******************** TableWriter *******************************
-	table := tablewriter.NewWriter(os.Stdout)
-	if !noHeaders {
-		table.SetHeader([]string{"VERSION", "CREATED_AT", "DELETED_AT", "DESTROYED"})
-		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
-	}
-	table.SetAutoWrapText(false)
-	table.SetAutoFormatHeaders(true)
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetCenterSeparator("")
-	table.SetColumnSeparator("")
-	table.SetRowSeparator("")
-	table.SetHeaderLine(false)
-	table.SetBorder(false)
-	table.SetTablePadding("\t") // pad with tabs
-	table.SetNoWhiteSpace(true)
-
-	row = []string{fmt.Sprintf("%d", vv.Version), vv.CreatedTime, vv.DeletionTime, fmt.Sprintf("%t", vv.Destroyed)}
-	table.Append(row)
-
-	table.Render()
-
-	return nil
-
+// Table - the table output of a single version
+func (vv *VaultVersion) Table() printer.Table {
+	table := printer.NewTable("VERSION", "CREATED_AT", "DELETED_AT", "DESTROYED")
+	table.AddRow(fmt.Sprintf("%d", vv.Version), vv.CreatedTime, vv.DeletionTime, fmt.Sprintf("%t", vv.Destroyed))
+	return table
 }
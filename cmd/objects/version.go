@@ -1,17 +1,12 @@
 package objects
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/blang/semver/v4"
-	"github.com/maahsome/gron"
-	"github.com/olekukonko/tablewriter"
 	"github.com/sirupsen/logrus"
-	"gopkg.in/yaml.v2"
+	"github.com/splicemachine/splicectl/printer"
 )
 
 // ApplyCmSettings - lookup names
@@ -67,8 +62,9 @@ type BaseVersion struct {
 	BuildDate string `json:"BuildDate"`
 }
 
-// RequirementMet - Check if the command is supported by the server version
-func (v *Version) RequirementMet(command string) (semver.Version, semver.Version) {
+// RequirementMet - Check if the command is supported by the server version,
+// an error is returned when the server is older than the command requires.
+func (v *Version) RequirementMet(command string) (semver.Version, semver.Version, error) {
 	cv, err := semver.Parse(CommandVersions[command])
 	if err != nil {
 		logrus.Warn(fmt.Sprintf("Error parsing SemVer for %s", CommandVersions[command]))
@@ -80,92 +76,15 @@ func (v *Version) RequirementMet(command string) (semver.Version, semver.Version
 	}
 
 	if sv.GTE(cv) {
-		return cv, sv
+		return cv, sv, nil
 	}
-	logrus.Fatal(fmt.Sprintf("The API server, version %s, does not support this call, the version needs to be v%s or higher", v.VersionInfo.Server.SemVer, CommandVersions[command]))
-	// Clearly we will never get here, though the compiler complains.
-	return semver.Version{}, semver.Version{}
+	return cv, sv, fmt.Errorf("the API server, version %s, does not support this call, the version needs to be v%s or higher", v.VersionInfo.Server.SemVer, CommandVersions[command])
 }
 
-// ToJSON - Write the output as JSON
-func (v *Version) ToJSON() error {
-
-	versionJSON, enverr := json.MarshalIndent(v, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-	fmt.Println(string(versionJSON[:]))
-
-	return nil
-
-}
-
-// ToGRON - Write the output as GRON
-func (v *Version) ToGRON() error {
-	verJSON, enverr := json.MarshalIndent(v, "", "  ")
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting json")
-		return enverr
-	}
-
-	subReader := strings.NewReader(string(verJSON[:]))
-	subValues := &bytes.Buffer{}
-	ges := gron.NewGron(subReader, subValues)
-	ges.SetMonochrome(false)
-	serr := ges.ToGron()
-	if serr != nil {
-		logrus.Error("Problem generating gron syntax", serr)
-		return serr
-	}
-	fmt.Println(string(subValues.Bytes()))
-
-	return nil
-
-}
-
-// ToYAML - Write the output as YAML
-func (v *Version) ToYAML() error {
-
-	versionYAML, enverr := yaml.Marshal(v)
-	if enverr != nil {
-		logrus.WithError(enverr).Error("Error extracting yaml")
-		return enverr
-	}
-	fmt.Println(string(versionYAML[:]))
-
-	return nil
-
-}
-
-// ToTEXT - Write the output as TEXT
-func (v *Version) ToTEXT(noHeaders bool) error {
-
-	var row []string
-
-	// ******************** TableWriter *******************************
-	table := tablewriter.NewWriter(os.Stdout)
-	if !noHeaders {
-		table.SetHeader([]string{"COMPONENT", "VERSION"})
-		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
-	}
-	table.SetAutoWrapText(false)
-	table.SetAutoFormatHeaders(true)
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetCenterSeparator("")
-	table.SetColumnSeparator("")
-	table.SetRowSeparator("")
-	table.SetHeaderLine(false)
-	table.SetBorder(false)
-	table.SetTablePadding("\t") // pad with tabs
-	table.SetNoWhiteSpace(true)
-	row = []string{"Client", v.VersionInfo.Client.SemVer}
-	table.Append(row)
-	row = []string{"Server", v.VersionInfo.Server.SemVer}
-	table.Append(row)
-
-	table.Render()
-
-	return nil
-
+// Table - the table output of the client and server versions
+func (v *Version) Table() printer.Table {
+	table := printer.NewTable("COMPONENT", "VERSION")
+	table.AddRow("Client", v.VersionInfo.Client.SemVer)
+	table.AddRow("Server", v.VersionInfo.Server.SemVer)
+	return table
 }
@@ -0,0 +1,20 @@
+package objects
+
+import "testing"
+
+func TestRequirementMet(t *testing.T) {
+	v := Version{}
+	v.VersionInfo.Server.SemVer = "v0.1.6"
+
+	cv, sv, err := v.RequirementMet("restart_database")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if cv.String() != "0.1.6" || sv.String() != "0.1.6" {
+		t.Fatalf("expected 0.1.6 and 0.1.6, got: %s and %s", cv, sv)
+	}
+
+	if _, _, err := v.RequirementMet("pause"); err == nil {
+		t.Fatalf("expected an error for a command newer than the server")
+	}
+}
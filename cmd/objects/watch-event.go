@@ -0,0 +1,45 @@
+package objects
+
+import "time"
+
+// The types of the changes seen by --watch
+const (
+	WatchAdded   = "added"
+	WatchChanged = "changed"
+	WatchRemoved = "removed"
+)
+
+// WatchEvent - a change of status seen by --watch, printed one per line
+// with -o json
+type WatchEvent struct {
+	Time     string `json:"time"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Status   string `json:"status,omitempty"`
+	Previous string `json:"previous,omitempty"`
+}
+
+// WatchEvents - the changes between the statuses of two polls, keyed by
+// name.  names is the order of current, the removed names follow it in the
+// order of previousNames.  A nil previous is the first poll, every name is
+// added.
+func WatchEvents(previous map[string]string, previousNames []string, current map[string]string, names []string, now time.Time) []WatchEvent {
+	stamp := now.UTC().Format(time.RFC3339)
+	var events []WatchEvent
+	for _, name := range names {
+		status := current[name]
+		old, seen := previous[name]
+		switch {
+		case !seen:
+			events = append(events, WatchEvent{Time: stamp, Type: WatchAdded, Name: name, Status: status})
+		case old != status:
+			events = append(events, WatchEvent{Time: stamp, Type: WatchChanged, Name: name, Status: status, Previous: old})
+		}
+	}
+	for _, name := range previousNames {
+		if _, ok := current[name]; !ok {
+			events = append(events, WatchEvent{Time: stamp, Type: WatchRemoved, Name: name, Previous: previous[name]})
+		}
+	}
+	return events
+}
@@ -0,0 +1,38 @@
+package objects
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWatchEvents(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	first := WatchEvents(nil, nil, map[string]string{"a": "Active", "b": "Paused"}, []string{"a", "b"}, now)
+	if len(first) != 2 || first[0].Type != WatchAdded || first[0].Name != "a" || first[1].Status != "Paused" {
+		t.Fatalf("expected every workspace to be added, got: %+v", first)
+	}
+	if first[0].Time != "2021-03-01T12:00:00Z" {
+		t.Fatalf("expected an RFC3339 time, got: %s", first[0].Time)
+	}
+
+	events := WatchEvents(map[string]string{"a": "Active", "b": "Paused"}, []string{"a", "b"},
+		map[string]string{"a": "Pausing", "c": "Creating"}, []string{"a", "c"}, now)
+	want := []WatchEvent{
+		{Type: WatchChanged, Name: "a", Status: "Pausing", Previous: "Active"},
+		{Type: WatchAdded, Name: "c", Status: "Creating"},
+		{Type: WatchRemoved, Name: "b", Previous: "Paused"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got: %+v", len(want), events)
+	}
+	for i, event := range events {
+		event.Time = ""
+		if event != want[i] {
+			t.Fatalf("event %d: expected %+v, got: %+v", i, want[i], event)
+		}
+	}
+
+	if unchanged := WatchEvents(map[string]string{"a": "Active"}, []string{"a"}, map[string]string{"a": "Active"}, []string{"a"}, now); len(unchanged) != 0 {
+		t.Fatalf("expected no events, got: %+v", unchanged)
+	}
+}
@@ -0,0 +1,114 @@
+package objects
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The fields of a workspace a selector can match
+const (
+	SelectorName      = "name"
+	SelectorStatus    = "status"
+	SelectorNamespace = "namespace"
+	SelectorAccount   = "account"
+	SelectorFreeTier  = "free-tier"
+)
+
+// selectorRequirement - one field=value or field!=value of a selector
+type selectorRequirement struct {
+	field   string
+	pattern string
+	negated bool
+}
+
+// WorkspaceSelector - matches workspaces on their fields, ie:
+// status=Active,namespace!=prod-*.  The values are case insensitive glob
+// patterns, and an empty selector matches every workspace.
+type WorkspaceSelector struct {
+	requirements []selectorRequirement
+}
+
+// ParseWorkspaceSelector - parse the comma separated requirements of spec
+func ParseWorkspaceSelector(spec string) (WorkspaceSelector, error) {
+	var selector WorkspaceSelector
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+		requirement := selectorRequirement{}
+		var kv []string
+		if strings.Contains(part, "!=") {
+			kv = strings.SplitN(part, "!=", 2)
+			requirement.negated = true
+		} else {
+			kv = strings.SplitN(part, "=", 2)
+		}
+		if len(kv) != 2 || len(kv[0]) == 0 {
+			return selector, fmt.Errorf("selector requirement %q must be in the form field=value or field!=value", part)
+		}
+		requirement.field = strings.ToLower(strings.TrimSpace(kv[0]))
+		requirement.pattern = strings.ToLower(strings.TrimSpace(kv[1]))
+
+		switch requirement.field {
+		case SelectorName, SelectorStatus, SelectorNamespace, SelectorAccount:
+		case SelectorFreeTier:
+			if _, err := strconv.ParseBool(requirement.pattern); err != nil {
+				return selector, fmt.Errorf("selector requirement %q must be true or false", part)
+			}
+		default:
+			return selector, fmt.Errorf("unknown selector field %q, valid fields are %s, %s, %s, %s and %s", requirement.field,
+				SelectorName, SelectorStatus, SelectorNamespace, SelectorAccount, SelectorFreeTier)
+		}
+		if _, err := filepath.Match(requirement.pattern, ""); err != nil {
+			return selector, fmt.Errorf("selector requirement %q has an invalid pattern: %v", part, err)
+		}
+		selector.requirements = append(selector.requirements, requirement)
+	}
+	return selector, nil
+}
+
+// Empty - the selector has no requirements
+func (s WorkspaceSelector) Empty() bool {
+	return len(s.requirements) == 0
+}
+
+// Matches - the workspace meets every requirement of the selector
+func (s WorkspaceSelector) Matches(info CMClusterInfo) bool {
+	for _, requirement := range s.requirements {
+		if requirement.matches(info) == requirement.negated {
+			return false
+		}
+	}
+	return true
+}
+
+func (r selectorRequirement) matches(info CMClusterInfo) bool {
+	switch r.field {
+	case SelectorName:
+		return MatchPattern(r.pattern, info.DcosAppId) || MatchPattern(r.pattern, info.Name)
+	case SelectorStatus:
+		return MatchPattern(r.pattern, info.Status)
+	case SelectorNamespace:
+		return MatchPattern(r.pattern, info.Namespace)
+	case SelectorAccount:
+		return MatchPattern(r.pattern, info.Account.AccountName) || MatchPattern(r.pattern, info.Account.AccountId)
+	case SelectorFreeTier:
+		freeTier, _ := strconv.ParseBool(r.pattern)
+		return info.FreeTier() == freeTier
+	}
+	return false
+}
+
+// MatchPattern - value matches the case insensitive glob pattern
+func MatchPattern(pattern string, value string) bool {
+	matched, _ := filepath.Match(strings.ToLower(pattern), strings.ToLower(value))
+	return matched
+}
+
+// IsPattern - name holds glob characters
+func IsPattern(name string) bool {
+	return strings.ContainsAny(name, "*?[")
+}
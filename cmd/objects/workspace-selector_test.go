@@ -0,0 +1,47 @@
+package objects
+
+import "testing"
+
+func TestWorkspaceSelector(t *testing.T) {
+	dev := CMClusterInfo{
+		DcosAppId: "dev-splicedb",
+		Namespace: "dev",
+		Status:    "Active",
+		Account:   CMAccount{AccountName: "Engineering", AccountId: "acc-1"},
+		ClusterConfigurations: []CMClusterConfiguration{
+			{FreeTier: false, EffectiveEndDate: "2020-01-01"},
+			{FreeTier: true},
+		},
+	}
+	prod := CMClusterInfo{DcosAppId: "splicedb", Namespace: "prod", Status: "Paused", Account: CMAccount{AccountId: "acc-2"}}
+
+	tests := []struct {
+		spec string
+		dev  bool
+		prod bool
+	}{
+		{"", true, true},
+		{"status=active", true, false},
+		{"namespace!=prod*", true, false},
+		{"name=*splicedb", true, true},
+		{"account=engineering", true, false},
+		{"account=acc-2", false, true},
+		{"free-tier=true", true, false},
+		{"free-tier=false,status=Paused", false, true},
+	}
+	for _, tt := range tests {
+		selector, err := ParseWorkspaceSelector(tt.spec)
+		if err != nil {
+			t.Fatalf("%q: expected no error, got: %v", tt.spec, err)
+		}
+		if selector.Matches(dev) != tt.dev || selector.Matches(prod) != tt.prod {
+			t.Errorf("%q: expected dev %t and prod %t, got: %t and %t", tt.spec, tt.dev, tt.prod, selector.Matches(dev), selector.Matches(prod))
+		}
+	}
+
+	for _, spec := range []string{"status", "=Active", "region=us", "free-tier=maybe", "name=[a"} {
+		if _, err := ParseWorkspaceSelector(spec); err == nil {
+			t.Errorf("expected an error for %q", spec)
+		}
+	}
+}
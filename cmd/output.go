@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/printer"
+)
+
+// selectedFormat - the output format requested with -o, defaultFormat when
+// the flag wasn't given.  The format name was lower cased by
+// rootCmd.PersistentPreRunE, arguments such as the jsonpath are kept as given.
+func selectedFormat(defaultFormat string) string {
+	if !formatOverridden {
+		return defaultFormat
+	}
+	return outputFormat
+}
+
+// rawOutput - the server response should be printed unchanged
+func rawOutput() bool {
+	return outputFormat == "raw"
+}
+
+// displayResponse - decode the server response in to v and print it in the
+// selected output format.
+func displayResponse(in string, v interface{}, defaultFormat string) error {
+	if rawOutput() {
+		fmt.Println(in)
+		return nil
+	}
+	if err := decodeResponse(in, v); err != nil {
+		return err
+	}
+	return printObject(v, defaultFormat)
+}
+
+// displayActionStatus - print the action status returned when a workspace is
+// created, paused, resumed, restarted or deleted.  An action the server
+// reports as failed fails the command.
+func displayActionStatus(in string) error {
+	var status objects.ActionStatus
+	if err := displayResponse(in, &status, "table"); err != nil {
+		return err
+	}
+	if rawOutput() {
+		if err := decodeResponse(in, &status); err != nil {
+			return err
+		}
+	}
+	return status.Err()
+}
+
+// decodeResponse - decode the server response in to v
+func decodeResponse(in string, v interface{}) error {
+	if err := json.Unmarshal([]byte(in), v); err != nil {
+		return withMessage(err, "Could not unmarshall data")
+	}
+	return nil
+}
+
+// printObject - print v to stdout in the selected output format
+func printObject(v interface{}, defaultFormat string) error {
+	return writeObject(os.Stdout, v, defaultFormat)
+}
+
+// writeObject - write v to w in the selected output format
+func writeObject(w io.Writer, v interface{}, defaultFormat string) error {
+	opts := printer.Options{NoHeaders: noHeaders}
+	if err := printer.Print(w, selectedFormat(defaultFormat), v, opts); err != nil {
+		return withMessage(err, "Error writing output")
+	}
+	return nil
+}
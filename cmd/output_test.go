@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDisplayActionStatus(t *testing.T) {
+	defer func(format string, overridden bool) { outputFormat, formatOverridden = format, overridden }(outputFormat, formatOverridden)
+	outputFormat, formatOverridden = "json", true
+
+	if err := displayActionStatus(`{"Process":"pause","Success":true,"database":"splicedb"}`); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	err := displayActionStatus(`{"Process":"pause","Success":false,"database":"splicedb","error":"not active"}`)
+	if err == nil || !strings.Contains(err.Error(), "not active") {
+		t.Fatalf("expected the failure of the server, got: %v", err)
+	}
+
+	outputFormat = "raw"
+	if err := displayActionStatus(`{"Process":"resume","Success":false}`); err == nil {
+		t.Fatal("expected a failed action to fail with -o raw")
+	}
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/client"
+	"github.com/splicemachine/splicectl/kube"
+)
+
+// portForwardTimeout - how long to wait for the port-forward to be ready
+const portForwardTimeout = 30 * time.Second
+
+var activePortForward *kube.PortForward
+
+// startPortForward - forward a local port to a splicectl-api pod for the
+// lifetime of the command, returns the local API server URI, or an empty
+// string when there is no kubeconfig to port-forward with.
+func startPortForward() (string, error) {
+	if client, err := kubeClient(); err != nil || client == nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), portForwardTimeout)
+	defer cancel()
+
+	portForward, err := kubeFactory.PortForward(ctx)
+	if err != nil {
+		return "", withMessage(err, "Could not port-forward to splicectl-api")
+	}
+	activePortForward = portForward
+	logrus.Info(fmt.Sprintf("Forwarding %s to pod %s/%s port %d",
+		portForward.URL(), kubeFactory.Namespace(), portForward.Target.Pod, portForward.Target.Port))
+	return portForward.URL(), nil
+}
+
+// fallbackPortForward - startPortForward when the API server was discovered
+// rather than requested, a port-forward that can't be started is only a
+// warning and an empty string is returned.
+func fallbackPortForward() (string, error) {
+	if client, err := kubeClient(); err != nil || client == nil {
+		return "", err
+	}
+	server, err := startPortForward()
+	if err != nil {
+		logrus.WithError(err).Warn("could not port-forward to splicectl-api")
+		return "", nil
+	}
+	return server, nil
+}
+
+// stopPortForward - stop the port-forward, if one was started
+func stopPortForward() {
+	if activePortForward != nil {
+		activePortForward.Close()
+		activePortForward = nil
+	}
+}
+
+// unreachable - the request never got a response, ie: the ingress of a
+// private cluster can't be reached from here
+func unreachable(err error) bool {
+	var requestErr *client.RequestError
+	return errors.As(err, &requestErr)
+}
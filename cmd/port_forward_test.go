@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/splicemachine/splicectl/kube"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStartPortForwardError(t *testing.T) {
+	saved := kubeFactory
+	defer func() { kubeFactory = saved }()
+	// no splicectl-api Service or pods to forward to
+	kubeFactory = kube.NewFactoryForClientset(fake.NewSimpleClientset(), nil, "")
+
+	if server, err := startPortForward(); err == nil {
+		t.Fatalf("expected --port-forward to fail, got: %q", server)
+	}
+	server, err := fallbackPortForward()
+	if err != nil || len(server) > 0 {
+		t.Fatalf("expected the fallback to only warn, got: %q %v", server, err)
+	}
+}
@@ -1,18 +1,11 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
-	"fmt"
-	"os"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/splicemachine/splicectl/cmd/objects"
-	"github.com/splicemachine/splicectl/common"
 )
 
 var resumeCmd = &cobra.Command{
@@ -22,116 +15,104 @@ var resumeCmd = &cobra.Command{
 	Long: `EXAMPLES
 	splicectl list workspace
 	splicectl resume --database-name <database> --message "<message>"
+	splicectl resume --database-name <database> --wait --timeout 15m
 	
-	Note: --database-name and -d are the preferred way to supply the database name.
-	However, --database and --workspace can also be used as well. In the event that
+	Note: --database-name and -d are the preferred way to supply the database name,
+	repeat them or use a glob pattern to select several workspaces. However,
+	--database and --workspace can also be used to name one. In the event that
 	more than one of them is supplied database-name and d are preferred over all
 	and workspace is preferred over database. The most preferred option that is
 	supplied will be used and a message will be displayed letting you know which
 	option was chosen if more than one were supplied.`,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var dberr error
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("resume")
-
 		message, _ := cmd.Flags().GetString("message")
-		databaseName := common.DatabaseName(cmd)
+		selection, err := newWorkspaceSelection(cmd)
+		if err != nil {
+			return err
+		}
+		if selection.bulk() {
+			return runBulk(cmd, selection, func(info objects.CMClusterInfo) objects.BulkResult {
+				if !info.Paused() {
+					return skipResult(info, "the workspace is not listed as Paused")
+				}
+				status, err := apiClient.ResumeDatabase(info.DcosAppId, message)
+				return actionResult(cmd, info, status, err, workspaceIn(objects.StatusActive))
+			})
+		}
+
+		databaseName := selection.name()
 		if len(databaseName) == 0 {
 			databaseName, dberr = promptForDatabaseName()
 			if dberr != nil {
-				logrus.Fatal("Could not get a list of workspaces", dberr)
+				return withMessage(dberr, "Could not get a list of workspaces")
 			}
 		}
-		if isDatabasePaused(databaseName) {
+		matched, err := isDatabasePaused(databaseName)
+		if err != nil {
+			return err
+		}
+		if matched {
 			out, err := resumeDatabase(databaseName, message)
 			if err != nil {
-				logrus.Warn("Resuming workspace failed.")
+				return withMessage(err, "Resuming workspace failed")
 			}
 
-			if semverV1, err := semver.ParseRange(">=0.1.7"); err != nil {
-				logrus.Fatal("Failed to parse SemVer")
-			} else {
-				if semverV1(sv) {
-					displayResumeDatabaseV1(out)
-				}
+			if err := displayFeature(cmd, out); err != nil {
+				return err
 			}
+			return waitForWorkspace(cmd, databaseName, workspaceIn(objects.StatusActive))
 		} else {
 			logrus.Warn("The workspace is not listed as Paused, not resuming")
 		}
-
+		return nil
 	},
 }
 
-func displayResumeDatabaseV1(in string) {
-	fmt.Println(in)
-	os.Exit(0)
+func displayResumeDatabaseV1(in string) error {
+	return displayActionStatus(in)
 }
 
-func isDatabasePaused(db string) bool {
+func isDatabasePaused(db string) (bool, error) {
 	dbJSON, err := getDatabaseList()
 	if err != nil {
-		logrus.WithError(err).Fatal("Error retreiving ClusterId list")
+		return false, withMessage(err, "Error retreiving ClusterId list")
 	}
 	var dbList objects.DatabaseList
 
 	marshErr := json.Unmarshal([]byte(dbJSON), &dbList)
 	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall workspace list for ClusterId", marshErr)
+		return false, withMessage(marshErr, "Could not unmarshall workspace list for ClusterId")
 	}
 
 	for _, v := range dbList.Clusters {
 		if v.DcosAppId == db {
 			if v.Status == "Paused" {
-				return true
+				return true, nil
 			}
-			return false
+			return false, nil
 		}
 	}
-	return false
+	return false, nil
 }
 
 func resumeDatabase(db string, msg string) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
-	}
-
-	uri := "splicectl/v1/splicedb/splicedatabaseresume"
-
-	var resp *resty.Response
-	var resperr error
-
-	reqJSON := fmt.Sprintf("{ \"appId\": \"%s\", \"message\": \"%s\" }", db, msg)
-	resp, resperr = restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		SetBody(reqJSON).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Default CR Info")
-		return "", resperr
+	out, err := apiClient.ResumeDatabaseRaw(db, msg)
+	if err != nil {
+		return "", err
 	}
 
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	rootCmd.AddCommand(resumeCmd)
+	declareFeature(resumeCmd, "resume", displayResumeDatabaseV1)
 
-	// add database name and aliases
-	resumeCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
-	resumeCmd.Flags().String("database", "", "Alias for database-name, prefer the use of -d and --database-name.")
-	resumeCmd.Flags().String("workspace", "", "Alias for database-name, prefer the use of -d and --database-name.")
+	// add database name, aliases and the bulk selection
+	addSelectionFlags(resumeCmd)
 
 	resumeCmd.Flags().StringP("message", "m", "", "Add a message to the workspace log")
+	addWaitFlags(resumeCmd)
+
 }
@@ -1,16 +1,10 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
 
 	"github.com/spf13/cobra"
@@ -23,94 +17,47 @@ var rollbackCMSettingsCmd = &cobra.Command{
 	splicectl versions cm-settings --component ui
 	splicectl rollback cm-settings --component ui --version 2
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("rollback_cm-settings")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		component, _ := cmd.Flags().GetString("component")
 
 		component = strings.ToLower(component)
 		if len(component) == 0 || !strings.Contains("ui api", component) {
-			logrus.Fatal("--component needs to be 'ui' or 'api'")
+			return fmt.Errorf("--component needs to be 'ui' or 'api'")
 		}
 		version, _ := cmd.Flags().GetInt("version")
-		out, err := rollbackCMSettings(component, version)
+		writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindCMSettings, Metadata: objects.ManifestMetadata{Component: component}})
 		if err != nil {
-			logrus.WithError(err).Error("Error rolling back CM Settings")
+			return err
 		}
-
-		if semverV1, err := semver.ParseRange(">=0.1.6"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayRollbackCmSettingsV1(out)
-			}
+		out, err := rollbackCMSettings(writer, component, version)
+		if err != nil {
+			return withMessage(err, "Error rolling back CM Settings")
 		}
+
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayRollbackCmSettingsV1(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
+func displayRollbackCmSettingsV1(in string) error {
 	var vvData objects.VaultVersion
-	marshErr := json.Unmarshal([]byte(in), &vvData)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vvData.ToJSON()
-	case "gron":
-		vvData.ToGRON()
-	case "yaml":
-		vvData.ToYAML()
-	case "text", "table":
-		vvData.ToTEXT(noHeaders)
-	}
+	return displayResponse(in, &vvData, "text")
 }
 
-func rollbackCMSettings(comp string, ver int) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
-	}
-
-	uri := fmt.Sprintf("splicectl/v1/vault/rollbackcmsettings?component=%s&version=%d", comp, ver)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error rolling back CM Settings")
-		return "", resperr
+func rollbackCMSettings(writer *client.Client, comp string, ver int) (string, error) {
+	out, err := writer.RollbackCMSettingsRaw(comp, ver)
+	if err != nil {
+		return "", err
 	}
 
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	rollbackCmd.AddCommand(rollbackCMSettingsCmd)
+	declareFeature(rollbackCMSettingsCmd, "rollback_cm-settings", displayRollbackCmSettingsV1)
 
 	rollbackCMSettingsCmd.Flags().StringP("component", "c", "", "Specify the component, <ui|api>")
 	rollbackCMSettingsCmd.Flags().Int("version", 0, "Specify the version to retrieve, default latest")
 	rollbackCMSettingsCmd.MarkFlagRequired("version")
+	addExpectedVersionFlag(rollbackCMSettingsCmd)
 }
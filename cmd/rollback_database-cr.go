@@ -1,16 +1,9 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
-	"os"
-	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
@@ -32,106 +25,51 @@ var rollbackDatabaseCRCmd = &cobra.Command{
 	supplied will be used and a message will be displayed letting you know which
 	option was chosen if more than one were supplied.
 `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var dberr error
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("rollback_database-cr")
-
 		databaseName := common.DatabaseName(cmd)
 		if len(databaseName) == 0 {
 			databaseName, dberr = promptForDatabaseName()
 			if dberr != nil {
-				logrus.Fatal("Could not get a list of workspaces", dberr)
+				return withMessage(dberr, "Could not get a list of workspaces")
 			}
 		}
 		version, _ := cmd.Flags().GetInt("version")
-		out, err := rollbackDatabaseCR(databaseName, version)
+		writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindDatabaseCR, Metadata: objects.ManifestMetadata{DatabaseName: databaseName}})
 		if err != nil {
-			logrus.WithError(err).Error("Error getting workspace CR Info")
+			return err
 		}
-
-		if semverV1, err := semver.ParseRange(">=0.0.15 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayRollbackDatabaseCRV1(out)
-			}
+		out, err := rollbackDatabaseCR(writer, databaseName, version)
+		if err != nil {
+			return withMessage(err, "Error getting workspace CR Info")
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayRollbackDatabaseCRV2(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayRollbackDatabaseCRV1(in string) {
+func displayRollbackDatabaseCRV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayRollbackDatabaseCRV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
+func displayRollbackDatabaseCRV2(in string) error {
 	var vvData objects.VaultVersion
-	marshErr := json.Unmarshal([]byte(in), &vvData)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vvData.ToJSON()
-	case "gron":
-		vvData.ToGRON()
-	case "yaml":
-		vvData.ToYAML()
-	case "text", "table":
-		vvData.ToTEXT(noHeaders)
-	}
+	return displayResponse(in, &vvData, "text")
 }
 
-func rollbackDatabaseCR(dbname string, ver int) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+func rollbackDatabaseCR(writer *client.Client, dbname string, ver int) (string, error) {
+	out, err := writer.RollbackDatabaseCRRaw(dbname, ver)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/vault/rollbackdatabasecr?version=%d&database-name=%s", ver, dbname)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting workspace CR Info")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	rollbackCmd.AddCommand(rollbackDatabaseCRCmd)
+	declareFeature(rollbackDatabaseCRCmd, "rollback_database-cr", displayRollbackDatabaseCRV1, displayRollbackDatabaseCRV2)
 
 	// add database name and aliases
 	rollbackDatabaseCRCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
@@ -143,4 +81,5 @@ func init() {
 	// rollbackDatabaseCRCmd.MarkFlagRequired("database-name")
 	rollbackDatabaseCRCmd.MarkFlagRequired("version")
 
+	addExpectedVersionFlag(rollbackDatabaseCRCmd)
 }
@@ -1,18 +1,10 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
-	"os"
-	"strings"
-
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
 
 	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
 )
 
@@ -23,101 +15,46 @@ var rollbackDefaultCRCmd = &cobra.Command{
 	splicectl versions default-cr
 	splicectl rollback default-cr --version 1
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("rollback_default-cr")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		version, _ := cmd.Flags().GetInt("version")
-		out, err := rollbackDefaultCR(version)
+		writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindDefaultCR})
 		if err != nil {
-			logrus.WithError(err).Error("Error getting Default CR Info")
+			return err
 		}
-
-		if semverV1, err := semver.ParseRange(">=0.0.15 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayRollbackDefaultCRV1(out)
-			}
+		out, err := rollbackDefaultCR(writer, version)
+		if err != nil {
+			return withMessage(err, "Error getting Default CR Info")
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayRollbackDefaultCRV2(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayRollbackDefaultCRV1(in string) {
+func displayRollbackDefaultCRV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayRollbackDefaultCRV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
+func displayRollbackDefaultCRV2(in string) error {
 	var vvData objects.VaultVersion
-	marshErr := json.Unmarshal([]byte(in), &vvData)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vvData.ToJSON()
-	case "gron":
-		vvData.ToGRON()
-	case "yaml":
-		vvData.ToYAML()
-	case "text", "table":
-		vvData.ToTEXT(noHeaders)
-	}
+	return displayResponse(in, &vvData, "text")
 }
 
-func rollbackDefaultCR(ver int) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+func rollbackDefaultCR(writer *client.Client, ver int) (string, error) {
+	out, err := writer.RollbackDefaultCRRaw(ver)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/vault/rollbackdefaultcr?version=%d", ver)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Default CR Info")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	rollbackCmd.AddCommand(rollbackDefaultCRCmd)
+	declareFeature(rollbackDefaultCRCmd, "rollback_default-cr", displayRollbackDefaultCRV1, displayRollbackDefaultCRV2)
 
 	rollbackDefaultCRCmd.Flags().String("output", "json", "Specify the output type")
 	rollbackDefaultCRCmd.Flags().Int("version", 0, "Specify the version to retrieve, default latest")
 	rollbackDefaultCRCmd.MarkFlagRequired("version")
+	addExpectedVersionFlag(rollbackDefaultCRCmd)
 }
@@ -1,16 +1,9 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
-	"os"
-	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
 
 	"github.com/spf13/cobra"
@@ -23,100 +16,45 @@ var rollbackSystemSettingsCmd = &cobra.Command{
 	splicectl versions system-settings
 	splicectl rollback system-settings --version 2
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("rollback_system-settings")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		version, _ := cmd.Flags().GetInt("version")
-		out, err := rollbackSystemSettings(version)
+		writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindSystemSettings})
 		if err != nil {
-			logrus.WithError(err).Error("Error rolling back System Settings")
+			return err
 		}
-
-		if semverV1, err := semver.ParseRange(">=0.0.15 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayRollbackSystemSettingsV1(out)
-			}
+		out, err := rollbackSystemSettings(writer, version)
+		if err != nil {
+			return withMessage(err, "Error rolling back System Settings")
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayRollbackSystemSettingsV2(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayRollbackSystemSettingsV1(in string) {
+func displayRollbackSystemSettingsV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayRollbackSystemSettingsV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
+func displayRollbackSystemSettingsV2(in string) error {
 	var vvData objects.VaultVersion
-	marshErr := json.Unmarshal([]byte(in), &vvData)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vvData.ToJSON()
-	case "gron":
-		vvData.ToGRON()
-	case "yaml":
-		vvData.ToYAML()
-	case "text", "table":
-		vvData.ToTEXT(noHeaders)
-	}
+	return displayResponse(in, &vvData, "text")
 }
 
-func rollbackSystemSettings(ver int) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
-	}
-
-	uri := fmt.Sprintf("splicectl/v1/vault/rollbacksystemsettings?version=%d", ver)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error rolling back System Settings")
-		return "", resperr
+func rollbackSystemSettings(writer *client.Client, ver int) (string, error) {
+	out, err := writer.RollbackSystemSettingsRaw(ver)
+	if err != nil {
+		return "", err
 	}
 
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	rollbackCmd.AddCommand(rollbackSystemSettingsCmd)
+	declareFeature(rollbackSystemSettingsCmd, "rollback_system-settings", displayRollbackSystemSettingsV1, displayRollbackSystemSettingsV2)
 
 	rollbackSystemSettingsCmd.Flags().Int("version", 0, "Specify the version to retrieve, default latest")
 	rollbackSystemSettingsCmd.MarkFlagRequired("version")
+	addExpectedVersionFlag(rollbackSystemSettingsCmd)
 }
@@ -1,16 +1,10 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/client"
 	"github.com/splicemachine/splicectl/cmd/objects"
 
 	"github.com/spf13/cobra"
@@ -23,107 +17,52 @@ var rollbackVaultKeyCmd = &cobra.Command{
 	splicectl versions vault-key --keypath services/cloudmanager/config/default/ui
 	splicectl rollback vault-key --keypath services/cloudmanager/config/default/ui --version 1
 	`,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("rollback_vault-key")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		keyPath, _ := cmd.Flags().GetString("keypath")
 		if strings.HasPrefix(keyPath, "secrets/") {
 			keyPath = strings.TrimPrefix(keyPath, "secrets/")
 		}
 		version, _ := cmd.Flags().GetInt("version")
-		out, err := rollbackVaultKeyData(keyPath, version)
+		writer, err := expectVersion(cmd, objects.Manifest{Kind: objects.KindVaultKey, Metadata: objects.ManifestMetadata{KeyPath: keyPath}})
 		if err != nil {
-			logrus.WithError(err).Error("Error rolling back Vault Key")
+			return err
 		}
-
-		if semverV1, err := semver.ParseRange(">=0.0.15 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayRollbackVaultKeyV1(out)
-			}
+		out, err := rollbackVaultKeyData(writer, keyPath, version)
+		if err != nil {
+			return withMessage(err, "Error rolling back Vault Key")
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayRollbackVaultKeyV2(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayRollbackVaultKeyV1(in string) {
+func displayRollbackVaultKeyV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayRollbackVaultKeyV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
-		fmt.Println(in)
-		os.Exit(0)
-	}
+func displayRollbackVaultKeyV2(in string) error {
 	var vvData objects.VaultVersion
-	marshErr := json.Unmarshal([]byte(in), &vvData)
-	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vvData.ToJSON()
-	case "gron":
-		vvData.ToGRON()
-	case "yaml":
-		vvData.ToYAML()
-	case "text", "table":
-		vvData.ToTEXT(noHeaders)
-	}
+	return displayResponse(in, &vvData, "text")
 }
 
-func rollbackVaultKeyData(keypath string, ver int) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
-	}
-
-	uri := fmt.Sprintf("splicectl/v1/vault/rollbackvaultkey?version=%d&keypath=%s", ver, keypath)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Post(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error rolling back Vault Key")
-		return "", resperr
+func rollbackVaultKeyData(writer *client.Client, keypath string, ver int) (string, error) {
+	out, err := writer.RollbackVaultKeyRaw(keypath, ver)
+	if err != nil {
+		return "", err
 	}
 
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	rollbackCmd.AddCommand(rollbackVaultKeyCmd)
+	declareFeature(rollbackVaultKeyCmd, "rollback_vault-key", displayRollbackVaultKeyV1, displayRollbackVaultKeyV2)
 
 	rollbackVaultKeyCmd.Flags().String("keypath", "", "Specify the vault key path")
 	rollbackVaultKeyCmd.Flags().String("output", "json", "Specify the output type")
 	rollbackVaultKeyCmd.Flags().Int("version", 0, "Specify the version to retrieve, default latest")
 	rollbackVaultKeyCmd.MarkFlagRequired("keypath")
 	rollbackVaultKeyCmd.MarkFlagRequired("version")
+	addExpectedVersionFlag(rollbackVaultKeyCmd)
 }
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	"github.com/splicemachine/splicectl/auth"
+	"github.com/splicemachine/splicectl/common"
+)
+
+// storeConfig - the default session store, keeping sessions in the config
+// file as it always has
+const storeConfig = "config"
+
+// configSessionStore - sessions kept in the config file, in the active
+// context when there is one, otherwise under <environment>-session_id and
+// <environment>-valid_until.
+type configSessionStore struct{}
+
+func (s *configSessionStore) Load(key string) (common.SessionData, error) {
+	if activeContext != nil {
+		return common.SessionData{
+			SessionID:  activeContext.SessionID,
+			ValidUntil: activeContext.ValidUntil,
+		}, nil
+	}
+	return common.SessionData{
+		SessionID:  viper.GetString(fmt.Sprintf("%s-session_id", key)),
+		ValidUntil: viper.GetString(fmt.Sprintf("%s-valid_until", key)),
+	}, nil
+}
+
+func (s *configSessionStore) Save(key string, session common.SessionData) error {
+	if activeContext == nil {
+		viper.Set(fmt.Sprintf("%s-session_id", key), session.SessionID)
+		viper.Set(fmt.Sprintf("%s-valid_until", key), session.ValidUntil)
+		return viper.WriteConfig()
+	}
+
+	contextList, err := loadContexts()
+	if err != nil {
+		return err
+	}
+	activeContext.SessionID = session.SessionID
+	activeContext.ValidUntil = session.ValidUntil
+	contextList.Set(*activeContext)
+	return saveContexts(contextList)
+}
+
+// newSessionStore - the session store named by the session-store config key
+// or $SPLICECTL_SESSION_STORE, the config file when neither is set.  The file
+// backends keep their files next to the config file.
+func newSessionStore() (auth.SessionStore, error) {
+	name := viper.GetString("session-store")
+	if len(name) == 0 || name == storeConfig {
+		return &configSessionStore{}, nil
+	}
+	return auth.NewSessionStore(name, filepath.Dir(viper.ConfigFileUsed()))
+}
+
+// sessionKey - the key sessions are stored under, the active context or the
+// environment name
+func sessionKey(environment string) string {
+	if activeContext != nil {
+		return "context/" + activeContext.Name
+	}
+	return environment
+}
+
+// loadSession - the stored session of the environment or active context
+func loadSession(environment string) (common.SessionData, error) {
+	return sessionStore.Load(sessionKey(environment))
+}
+
+// storeSession - save the session of a successful auth, an empty session
+// clears it
+func storeSession(environment string, sessionID string, validUntil string) error {
+	return sessionStore.Save(sessionKey(environment), common.SessionData{
+		SessionID:  sessionID,
+		ValidUntil: validUntil,
+	})
+}
@@ -2,15 +2,26 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
-	"github.com/sirupsen/logrus"
 	"github.com/splicemachine/splicectl/cmd/objects"
 )
 
+// confirmPrompt - ask a yes or no question, no is the default answer.  It
+// fails when stdin isn't a terminal to answer from.
+var confirmPrompt = func(message string) (bool, error) {
+	if !isTerminal(os.Stdin) {
+		return false, errors.New("a confirmation is required but stdin is not a terminal, use --yes")
+	}
+	confirmed := false
+	err := survey.AskOne(&survey.Confirm{Message: message}, &confirmed, survey.WithStdio(os.Stdin, os.Stderr, os.Stderr))
+	return confirmed, err
+}
+
 func promptForCSP() (string, error) {
 
 	cspList := []string{
@@ -54,15 +65,14 @@ func promptForCSP() (string, error) {
 func promptForAccountID() (string, error) {
 	out, err := getAccounts()
 	if err != nil {
-		logrus.WithError(err).Error("Error getting Default CR Info")
-		return "", err
+		return "", withMessage(err, "Error getting Accounts")
 	}
 
 	var accounts objects.AccountList
 
 	marshErr := json.Unmarshal([]byte(out), &accounts)
 	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
+		return "", withMessage(marshErr, "Could not unmarshall data")
 	}
 
 	var acctArray []string
@@ -102,14 +112,13 @@ func promptForAccountID() (string, error) {
 func promptForDatabaseName() (string, error) {
 	out, err := getDatabaseList()
 	if err != nil {
-		logrus.WithError(err).Error("Error getting Database List")
-		return "", err
+		return "", withMessage(err, "Error getting Database List")
 	}
 	var dbList objects.DatabaseList
 
 	marshErr := json.Unmarshal([]byte(out), &dbList)
 	if marshErr != nil {
-		logrus.Fatal("Could not unmarshall data", marshErr)
+		return "", withMessage(marshErr, "Could not unmarshall data")
 	}
 	var dbArray []string
 	for _, v := range dbList.Clusters {
@@ -142,9 +151,7 @@ func promptForDatabaseName() (string, error) {
 	// perform the questions
 	err = survey.Ask(qs, &answers, opts)
 	if err != nil {
-		logrus.Fatal("No databases on the list")
-		// fmt.Println(err.Error())
-		// return
+		return "", withMessage(err, "No databases on the list")
 	}
 	return answers.DatabaseName, nil
 
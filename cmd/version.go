@@ -1,71 +1,86 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"strings"
 
-	"github.com/go-resty/resty/v2"
+	"github.com/blang/semver/v4"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
 )
 
 var versionCmd = &cobra.Command{
 	Use:     "version",
 	Short:   "Express the 'version' of splicectl.",
 	Aliases: []string{"v"},
-	Run: func(cmd *cobra.Command, args []string) {
-
-		if !formatOverridden {
-			outputFormat = "yaml"
+	Long: `EXAMPLES
+	splicectl version
+	splicectl version --check
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if check, _ := cmd.Flags().GetBool("check"); check {
+			return checkVersion()
 		}
 
-		switch strings.ToLower(outputFormat) {
-		case "raw":
-			fmt.Println(versionJSON)
-		case "json":
+		switch selectedFormat("yaml") {
+		case "raw", "json":
 			// We want to print the JSON in a condensed format
 			fmt.Println(versionJSON)
-		case "gron":
-			versionDetail.ToGRON()
-		case "yaml":
-			versionDetail.ToYAML()
-		case "text", "table":
-			versionDetail.ToTEXT(noHeaders)
+		default:
+			return printObject(&versionDetail, "yaml")
 		}
+		return nil
 	},
 }
 
-func getVersionInfo() (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+// checkVersion - print the commands the server supports, it fails when a
+// critical command isn't supported.
+func checkVersion() error {
+	report := objects.NewCompatibilityReport(versionDetail, serverCapabilities())
+	if err := printObject(&report, "table"); err != nil {
+		return err
 	}
 
-	uri := "splicectl"
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		// SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		// SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
+	if clientOlderThanServer() {
+		logrus.Warn(fmt.Sprintf("splicectl %s is older than the API server %s, please upgrade splicectl", report.Client, report.Server))
+	}
+	if missing := report.MissingCritical(); len(missing) > 0 {
+		logrus.Error(fmt.Sprintf("The API server does not support the critical commands: %s", strings.Join(missing, ", ")))
+		return &exitStatus{code: exitUnsupported}
+	}
+	return nil
+}
 
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting version info")
-		return "", resperr
+// clientOlderThanServer - the release of splicectl is older than the API
+// server, false when either version can't be parsed
+func clientOlderThanServer() bool {
+	_, client, err := ClientSemVer()
+	if err != nil {
+		return false
 	}
+	cv, err := semver.Parse(strings.TrimPrefix(client, "v"))
+	if err != nil {
+		return false
+	}
+	sv, err := semver.Parse(strings.TrimPrefix(versionDetail.VersionInfo.Server.SemVer, "v"))
+	if err != nil {
+		return false
+	}
+	return cv.LT(sv)
+}
 
-	return strings.TrimSuffix(string(resp.Body()[:]), "\n"), nil
+func getVersionInfo() (string, error) {
+	out, err := apiClient.ServerVersionRaw()
+	if err != nil {
+		return "", err
+	}
 
+	return string(out[:]), nil
 }
 
 func init() {
 	rootCmd.AddCommand(versionCmd)
+
+	versionCmd.Flags().Bool("check", false, "Report the commands the API server supports, fails when a critical command isn't supported")
 }
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestCheckVersion(t *testing.T) {
+	withServer(t, "v0.0.13", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	var status *exitStatus
+	if err := checkVersion(); !errors.As(err, &status) || status.code != exitUnsupported {
+		t.Fatalf("expected exit code %d, got: %v", exitUnsupported, err)
+	}
+
+	versionDetail.VersionInfo.Server.SemVer = "v0.1.6"
+	negotiated = nil
+	if err := checkVersion(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+}
+
+func TestClientOlderThanServer(t *testing.T) {
+	saved := semVer
+	defer func() { semVer = saved }()
+	withServer(t, "v0.1.7", func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		client string
+		older  bool
+	}{
+		{"v0.1.6-cacert", true},
+		{"v0.1.7", false},
+		{"v0.2.0", false},
+		{"dev", false},
+	}
+	for _, tt := range tests {
+		semVer = tt.client
+		if got := clientOlderThanServer(); got != tt.older {
+			t.Errorf("%s: expected %t, got: %t", tt.client, tt.older, got)
+		}
+	}
+}
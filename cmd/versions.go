@@ -1,7 +1,16 @@
 package cmd
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/common"
+	"github.com/splicemachine/splicectl/diff"
 )
 
 var versionsCmd = &cobra.Command{
@@ -13,10 +22,94 @@ var versionsCmd = &cobra.Command{
 	splicectl versions default-cr
 	splicectl list workspace
 	splicectl versions database-cr --database-name splicedb
+
+	Each subcommand can also show a single version, or the differences
+	between two versions, before picking a rollback target.
+	splicectl versions default-cr --show 7
+	splicectl versions default-cr --diff 7..9
+	splicectl versions default-cr --diff 7..
 	`,
 	Run: func(cmd *cobra.Command, args []string) {},
 }
 
+// addVersionsFlags - add the --show and --diff flags to a versions subcommand
+func addVersionsFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("show", 0, "Show the value stored at the given version")
+	cmd.Flags().String("diff", "", "Show the differences between two versions, FROM..TO, ie: 7..9, leave off TO to compare with the latest")
+}
+
+// runVersionsFlags - handle --show and --diff for the resource the manifest
+// describes, returns false when neither flag was given.
+func runVersionsFlags(cmd *cobra.Command, m objects.Manifest) (bool, error) {
+	show, _ := cmd.Flags().GetInt("show")
+	rangeSpec, _ := cmd.Flags().GetString("diff")
+	switch {
+	case show > 0 && len(rangeSpec) > 0:
+		return true, errors.New("--show and --diff can not be used together")
+	case show > 0:
+		return true, showVersion(m, show)
+	case len(rangeSpec) > 0:
+		from, to, err := common.ParseVersionRange(rangeSpec)
+		if err != nil {
+			return true, withMessage(err, "Invalid --diff")
+		}
+		return true, diffVersions(m, from, to)
+	}
+	return false, nil
+}
+
+// showVersion - print the value stored at version
+func showVersion(m objects.Manifest, version int) error {
+	out, err := storedValue(m, version)
+	if err != nil {
+		return withMessage(err, fmt.Sprintf("Error getting version %d", version))
+	}
+	var value map[string]interface{}
+	return displayResponse(string(out), &value, "yaml")
+}
+
+// diffVersions - print the changes made between the from and to versions,
+// a to of 0 is the latest version.
+func diffVersions(m objects.Manifest, from int, to int) error {
+	fromValue, err := storedValue(m, from)
+	if err != nil {
+		return withMessage(err, fmt.Sprintf("Error getting version %d", from))
+	}
+	toValue, err := storedValue(m, to)
+	if err != nil {
+		return withMessage(err, fmt.Sprintf("Error getting version %d", to))
+	}
+	changes, err := diff.CompareJSON(fromValue, toValue)
+	if err != nil {
+		return withMessage(err, "Error comparing versions")
+	}
+
+	toName := "latest"
+	if to > 0 {
+		toName = fmt.Sprintf("%d", to)
+	}
+	if formatOverridden {
+		if changes == nil {
+			changes = []diff.Change{}
+		}
+		if rawOutput() {
+			out, _ := json.Marshal(changes)
+			fmt.Println(string(out))
+			return nil
+		}
+		return printObject(changes, "json")
+	}
+	if len(changes) == 0 {
+		logrus.Info(fmt.Sprintf("There are no differences between version %d and %s", from, toName))
+		return nil
+	}
+	fmt.Printf("%s version %d => %s\n", manifestTitle(m), from, toName)
+	if err := diff.Write(os.Stdout, changes); err != nil {
+		return withMessage(err, "Error writing output")
+	}
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(versionsCmd)
 }
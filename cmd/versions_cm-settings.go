@@ -1,15 +1,10 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"os"
 	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
 	"github.com/spf13/cobra"
@@ -21,92 +16,58 @@ var versionsCMSettingsCmd = &cobra.Command{
 	Long: `EXAMPLES
 	splicectl versions cm-settings --component ui
 	splicectl versions cm-settings --component api
+	splicectl versions cm-settings --component ui --diff 2..5
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("versions_cm-settings")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		component, _ := cmd.Flags().GetString("component")
 
 		component = strings.ToLower(component)
 		if len(component) == 0 || !strings.Contains("ui api", component) {
-			logrus.Fatal("--component needs to be 'ui' or 'api'")
+			return fmt.Errorf("--component needs to be 'ui' or 'api'")
+		}
+		manifest := objects.Manifest{
+			Kind:     objects.KindCMSettings,
+			Metadata: objects.ManifestMetadata{Component: component},
 		}
+		if handled, err := runVersionsFlags(cmd, manifest); handled || err != nil {
+			return err
+		}
+
 		out, err := getCMSettingsVersions(component)
 		if err != nil {
-			logrus.WithError(err).Error("Error getting CM Settings")
+			return withMessage(err, "Error getting CM Settings")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.1.6"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayVersionsCmSettingsV1(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayVersionsCmSettingsV1(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
+func displayVersionsCmSettingsV1(in string) error {
+	if rawOutput() {
 		fmt.Println(in)
-		os.Exit(0)
+		return nil
 	}
 	ssData, cerr := common.RestructureVersions(in)
 	if cerr != nil {
-		logrus.Fatal("Vault Version JSON conversion failed.")
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		ssData.ToJSON()
-	case "gron":
-		ssData.ToGRON()
-	case "yaml":
-		ssData.ToYAML()
-	case "text", "table":
-		ssData.ToTEXT(noHeaders)
+		return withMessage(cerr, "Vault Version JSON conversion failed.")
 	}
 
+	return printObject(&ssData, "text")
 }
 
 func getCMSettingsVersions(comp string) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+	out, err := apiClient.CMSettingsVersionsRaw(comp)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/vault/cmsettingsversions?component=%s", comp)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting System Settings")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	versionsCmd.AddCommand(versionsCMSettingsCmd)
+	declareFeature(versionsCMSettingsCmd, "versions_cm-settings", displayVersionsCmSettingsV1)
+	addVersionsFlags(versionsCMSettingsCmd)
 	versionsCMSettingsCmd.Flags().StringP("component", "c", "", "Specify the component, <ui|api>")
 
 }
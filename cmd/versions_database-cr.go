@@ -1,15 +1,9 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"os"
-	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
 	"github.com/spf13/cobra"
@@ -21,6 +15,7 @@ var versionsDatabaseCRCmd = &cobra.Command{
 	Long: `EXAMPLES
 	splicectl list workspace
 	splicectl versions workspace-cr --database-name splicedb
+	splicectl versions database-cr --database-name splicedb --diff 3..
 
 	Note: --database-name and -d are the preferred way to supply the database name.
 	However, --database and --workspace can also be used as well. In the event that
@@ -29,104 +24,63 @@ var versionsDatabaseCRCmd = &cobra.Command{
 	supplied will be used and a message will be displayed letting you know which
 	option was chosen if more than one were supplied.
 `,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		var dberr error
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("versions_database-cr")
-
 		databaseName := common.DatabaseName(cmd)
 		if len(databaseName) == 0 {
 			databaseName, dberr = promptForDatabaseName()
 			if dberr != nil {
-				logrus.Fatal("Could not get a list of workspaces", dberr)
+				return withMessage(dberr, "Could not get a list of workspaces")
 			}
 		}
-		out, err := getDatabaseCRVersions(databaseName)
-		if err != nil {
-			logrus.WithError(err).Error("Error getting workspace CR versions")
+		manifest := objects.Manifest{
+			Kind:     objects.KindDatabaseCR,
+			Metadata: objects.ManifestMetadata{DatabaseName: databaseName},
 		}
-
-		if semverV1, err := semver.ParseRange(">=0.0.15 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayVersionsDatabaseCRV1(out)
-			}
+		if handled, err := runVersionsFlags(cmd, manifest); handled || err != nil {
+			return err
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayVersionsDatabaseCRV2(out)
-			}
+		out, err := getDatabaseCRVersions(databaseName)
+		if err != nil {
+			return withMessage(err, "Error getting workspace CR versions")
 		}
+
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayVersionsDatabaseCRV1(in string) {
+func displayVersionsDatabaseCRV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayVersionsDatabaseCRV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
+func displayVersionsDatabaseCRV2(in string) error {
+	if rawOutput() {
 		fmt.Println(in)
-		os.Exit(0)
+		return nil
 	}
 	crData, cerr := common.RestructureVersions(in)
 	if cerr != nil {
-		logrus.Fatal("Vault Version JSON conversion failed.")
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
+		return withMessage(cerr, "Vault Version JSON conversion failed.")
 	}
 
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		crData.ToJSON()
-	case "gron":
-		crData.ToGRON()
-	case "yaml":
-		crData.ToYAML()
-	case "text", "table":
-		crData.ToTEXT(noHeaders)
-	}
+	return printObject(&crData, "text")
 }
 
 func getDatabaseCRVersions(db string) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+	out, err := apiClient.DatabaseCRVersionsRaw(db)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/vault/databasecrversions?database-name=%s", db)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting workspace CR Versions")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	versionsCmd.AddCommand(versionsDatabaseCRCmd)
+	declareFeature(versionsDatabaseCRCmd, "versions_database-cr", displayVersionsDatabaseCRV1, displayVersionsDatabaseCRV2)
+	addVersionsFlags(versionsDatabaseCRCmd)
 
 	// add database name and aliases
 	versionsDatabaseCRCmd.Flags().StringP("database-name", "d", "", "Specify the database name")
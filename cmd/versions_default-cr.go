@@ -1,17 +1,10 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"os"
-	"strings"
-
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
 
 	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 )
 
@@ -20,98 +13,52 @@ var versionsDefaultCRCmd = &cobra.Command{
 	Short: "Retrieve the versions of the default CR in the cluster.",
 	Long: `EXAMPLES
 	splicectl versions default-cr
+	splicectl versions default-cr --diff 7..9
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("versions_default-cr")
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if handled, err := runVersionsFlags(cmd, objects.Manifest{Kind: objects.KindDefaultCR}); handled || err != nil {
+			return err
+		}
 
 		out, err := getDefaultCRVersions()
 		if err != nil {
-			logrus.WithError(err).Error("Error getting Default CR Info")
+			return withMessage(err, "Error getting Default CR Info")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.0.15 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayVersionsDefaultCRV1(out)
-			}
-		}
-
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayVersionsDefaultCRV2(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayVersionsDefaultCRV1(in string) {
+func displayVersionsDefaultCRV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayVersionsDefaultCRV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
+func displayVersionsDefaultCRV2(in string) error {
+	if rawOutput() {
 		fmt.Println(in)
-		os.Exit(0)
+		return nil
 	}
 	crData, cerr := common.RestructureVersions(in)
 	if cerr != nil {
-		logrus.Fatal("Vault Version JSON conversion failed.")
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		crData.ToJSON()
-	case "gron":
-		crData.ToGRON()
-	case "yaml":
-		crData.ToYAML()
-	case "text", "table":
-		crData.ToTEXT(noHeaders)
+		return withMessage(cerr, "Vault Version JSON conversion failed.")
 	}
 
+	return printObject(&crData, "text")
 }
 
 func getDefaultCRVersions() (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+	out, err := apiClient.DefaultCRVersionsRaw()
+	if err != nil {
+		return "", err
 	}
 
-	uri := "splicectl/v1/vault/defaultcrversions"
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Default CR Info")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	versionsCmd.AddCommand(versionsDefaultCRCmd)
+	declareFeature(versionsDefaultCRCmd, "versions_default-cr", displayVersionsDefaultCRV1, displayVersionsDefaultCRV2)
+	addVersionsFlags(versionsDefaultCRCmd)
 
 }
@@ -1,15 +1,9 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"os"
-	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
 	"github.com/spf13/cobra"
@@ -20,97 +14,52 @@ var versionsSystemSettingsCmd = &cobra.Command{
 	Short: "Retrieve the versions of the system settings in the cluster.",
 	Long: `EXAMPLES
 	splicectl versions system-settings
+	splicectl versions system-settings --show 4
 `,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("versions_system-settings")
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if handled, err := runVersionsFlags(cmd, objects.Manifest{Kind: objects.KindSystemSettings}); handled || err != nil {
+			return err
+		}
 
 		out, err := getSystemSettingsVersions()
 		if err != nil {
-			logrus.WithError(err).Error("Error getting System Settings")
+			return withMessage(err, "Error getting System Settings")
 		}
 
-		if semverV1, err := semver.ParseRange(">=0.0.15 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayVersionsSystemSettingsV1(out)
-			}
-		}
-
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayVersionsSystemSettingsV2(out)
-			}
-		}
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayVersionsSystemSettingsV1(in string) {
+func displayVersionsSystemSettingsV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayVersionsSystemSettingsV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
+func displayVersionsSystemSettingsV2(in string) error {
+	if rawOutput() {
 		fmt.Println(in)
-		os.Exit(0)
+		return nil
 	}
 	ssData, cerr := common.RestructureVersions(in)
 	if cerr != nil {
-		logrus.Fatal("Vault Version JSON conversion failed.")
-	}
-
-	if !formatOverridden {
-		outputFormat = "text"
+		return withMessage(cerr, "Vault Version JSON conversion failed.")
 	}
 
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		ssData.ToJSON()
-	case "gron":
-		ssData.ToGRON()
-	case "yaml":
-		ssData.ToYAML()
-	case "text", "table":
-		ssData.ToTEXT(noHeaders)
-	}
+	return printObject(&ssData, "text")
 }
 
 func getSystemSettingsVersions() (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+	out, err := apiClient.SystemSettingsVersionsRaw()
+	if err != nil {
+		return "", err
 	}
 
-	uri := "splicectl/v1/vault/systemsettingsversions"
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting System Settings")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	versionsCmd.AddCommand(versionsSystemSettingsCmd)
+	declareFeature(versionsSystemSettingsCmd, "versions_system-settings", displayVersionsSystemSettingsV1, displayVersionsSystemSettingsV2)
+	addVersionsFlags(versionsSystemSettingsCmd)
 
 }
@@ -1,15 +1,10 @@
 package cmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"os"
 	"strings"
 
-	"github.com/blang/semver/v4"
-	"github.com/go-resty/resty/v2"
-	"github.com/sirupsen/logrus"
+	"github.com/splicemachine/splicectl/cmd/objects"
 	"github.com/splicemachine/splicectl/common"
 
 	"github.com/spf13/cobra"
@@ -20,102 +15,61 @@ var versionsVaultKeyCmd = &cobra.Command{
 	Short: "Retrieve the versions of a specified vault key from the cluster.",
 	Long: `EXAMPLES
 	splicectl versions vault-key --keypath services/cloudmanager/config/default/ui
+	splicectl versions vault-key --keypath services/cloudmanager/config/default/ui --show 2
 	`,
-	Run: func(cmd *cobra.Command, args []string) {
-
-		var sv semver.Version
-
-		_, sv = versionDetail.RequirementMet("versions_vault-key")
-
+	RunE: func(cmd *cobra.Command, args []string) error {
 		keyPath, _ := cmd.Flags().GetString("keypath")
 		if strings.HasPrefix(keyPath, "secrets/") {
 			keyPath = strings.TrimPrefix(keyPath, "secrets/")
 		}
-		out, err := getVaultKeyVersionData(keyPath)
-		if err != nil {
-			logrus.WithError(err).Error("Error getting Default CR Info")
+		manifest := objects.Manifest{
+			Kind:     objects.KindVaultKey,
+			Metadata: objects.ManifestMetadata{KeyPath: keyPath},
 		}
-
-		if semverV1, err := semver.ParseRange(">=0.0.15 <0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV1(sv) {
-				displayVersionsVaultKeyV1(out)
-			}
+		if handled, err := runVersionsFlags(cmd, manifest); handled || err != nil {
+			return err
 		}
 
-		if semverV2, err := semver.ParseRange(">=0.0.17"); err != nil {
-			logrus.Fatal("Failed to parse SemVer")
-		} else {
-			if semverV2(sv) {
-				displayVersionsVaultKeyV2(out)
-			}
+		out, err := getVaultKeyVersionData(keyPath)
+		if err != nil {
+			return withMessage(err, "Error getting Default CR Info")
 		}
+
+		return displayFeature(cmd, out)
 	},
 }
 
-func displayVersionsVaultKeyV1(in string) {
+func displayVersionsVaultKeyV1(in string) error {
 	fmt.Println(in)
-	os.Exit(0)
+	return nil
 }
 
-func displayVersionsVaultKeyV2(in string) {
-	if strings.ToLower(outputFormat) == "raw" {
+func displayVersionsVaultKeyV2(in string) error {
+	if rawOutput() {
 		fmt.Println(in)
-		os.Exit(0)
+		return nil
 	}
 	vkData, cerr := common.RestructureVersions(in)
 	if cerr != nil {
-		logrus.Fatal("Vault Version JSON conversion failed.")
+		return withMessage(cerr, "Vault Version JSON conversion failed.")
 	}
 
-	if !formatOverridden {
-		outputFormat = "text"
-	}
-
-	switch strings.ToLower(outputFormat) {
-	case "json":
-		vkData.ToJSON()
-	case "gron":
-		vkData.ToGRON()
-	case "yaml":
-		vkData.ToYAML()
-	case "text", "table":
-		vkData.ToTEXT(noHeaders)
-	}
+	return printObject(&vkData, "text")
 }
 
 func getVaultKeyVersionData(keypath string) (string, error) {
-	restClient := resty.New()
-	// Check if we've set a caBundle (via --ca-cert parameter)
-	if len(caBundle) > 0 {
-		roots := x509.NewCertPool()
-		ok := roots.AppendCertsFromPEM([]byte(caBundle))
-		if !ok {
-			logrus.Info("Failed to parse CABundle")
-		}
-		restClient.SetTLSClientConfig(&tls.Config{RootCAs: roots})
+	out, err := apiClient.VaultKeyVersionsRaw(keypath)
+	if err != nil {
+		return "", err
 	}
 
-	uri := fmt.Sprintf("splicectl/v1/vault/vaultkeyversions?keypath=%s", keypath)
-	resp, resperr := restClient.R().
-		SetHeader("Content-Type", "application/json").
-		SetHeader("Accept", "application/json").
-		SetHeader("X-Token-Bearer", authClient.GetTokenBearer()).
-		SetHeader("X-Token-Session", authClient.GetSessionID()).
-		Get(fmt.Sprintf("%s/%s", apiServer, uri))
-
-	if resperr != nil {
-		logrus.WithError(resperr).Error("Error getting Default CR Info")
-		return "", resperr
-	}
-
-	return string(resp.Body()[:]), nil
-
+	return string(out[:]), nil
 }
 
 func init() {
 	versionsCmd.AddCommand(versionsVaultKeyCmd)
+	declareFeature(versionsVaultKeyCmd, "versions_vault-key", displayVersionsVaultKeyV1, displayVersionsVaultKeyV2)
+	addVersionsFlags(versionsVaultKeyCmd)
 
 	versionsVaultKeyCmd.Flags().String("keypath", "", "Specify the vault key path")
 	versionsVaultKeyCmd.MarkFlagRequired("keypath")
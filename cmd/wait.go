@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+// defaultWaitTimeout - how long --wait waits when --timeout isn't given
+const defaultWaitTimeout = 10 * time.Minute
+
+// The first and the longest interval between two polls of --wait, the
+// interval doubles after each poll.
+var (
+	waitInterval    = 2 * time.Second
+	maxWaitInterval = 30 * time.Second
+)
+
+// waitCondition - whether the workspace reached the state waited for, found
+// is false once the workspace is no longer listed
+type waitCondition struct {
+	state string
+	done  func(info objects.CMClusterInfo, found bool) bool
+}
+
+// workspaceIn - the workspace is listed with status
+func workspaceIn(status string) waitCondition {
+	return waitCondition{
+		state: status,
+		done: func(info objects.CMClusterInfo, found bool) bool {
+			return found && strings.EqualFold(info.Status, status)
+		},
+	}
+}
+
+// workspaceRestarted - the workspace is Active again after a restart.  A
+// workspace being restarted is usually Active already, so it must first be
+// seen in another status or with an UpdatedAt other than the one of before,
+// the workspace as listed before the restart was requested.
+func workspaceRestarted(before objects.CMClusterInfo) waitCondition {
+	restarting := false
+	return waitCondition{
+		state: "restarted",
+		done: func(info objects.CMClusterInfo, found bool) bool {
+			if !found {
+				return false
+			}
+			active := strings.EqualFold(info.Status, objects.StatusActive)
+			if !active || info.UpdatedAt != before.UpdatedAt {
+				restarting = true
+			}
+			return restarting && active
+		},
+	}
+}
+
+// workspaceGone - the workspace is no longer listed or is marked deleted
+func workspaceGone() waitCondition {
+	return waitCondition{
+		state: "deleted",
+		done: func(info objects.CMClusterInfo, found bool) bool {
+			return !found || len(info.DeletedAt) > 0
+		},
+	}
+}
+
+// waitTimeoutError - the workspace didn't reach the state in time
+type waitTimeoutError struct {
+	database string
+	state    string
+	status   string
+	timeout  time.Duration
+}
+
+func (e *waitTimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for %s to be %s, it is %s", e.timeout, e.database, e.state, e.status)
+}
+
+// addWaitFlags - the --wait and --timeout flags of the commands changing the
+// state of a workspace
+func addWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("wait", false, "Wait for the workspace to reach its new state")
+	cmd.Flags().Duration("timeout", defaultWaitTimeout, "How long --wait waits, ie: 90s or 15m")
+}
+
+// waitForWorkspace - when --wait is given, poll the workspace list until the
+// workspace meets condition.  The progress is written to stderr, and a
+// workspace that failed or the timeout expiring is an error.
+func waitForWorkspace(cmd *cobra.Command, database string, condition waitCondition) error {
+	if wait, _ := cmd.Flags().GetBool("wait"); !wait {
+		return nil
+	}
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+
+	start := time.Now()
+	deadline := start.Add(timeout)
+	interval := waitInterval
+	for {
+		sleep := interval
+		if remaining := time.Until(deadline); remaining < sleep {
+			sleep = remaining
+		}
+		if sleep > 0 {
+			time.Sleep(sleep)
+		}
+
+		dbList, err := apiClient.DatabaseList()
+		if err != nil {
+			return withMessage(err, "Error retrieving the workspace list")
+		}
+		info, found := dbList.Find(database)
+		if condition.done(info, found) {
+			fmt.Fprintf(os.Stderr, "%s is %s after %s\n", database, condition.state, time.Since(start).Round(time.Second))
+			return nil
+		}
+		status := "not listed"
+		if found {
+			status = info.Status
+		}
+		if found && info.Failed() {
+			return fmt.Errorf("%s failed to become %s, it is %s", database, condition.state, info.Status)
+		}
+		if !time.Now().Before(deadline) {
+			return &waitTimeoutError{database: database, state: condition.state, status: status, timeout: timeout}
+		}
+		fmt.Fprintf(os.Stderr, "Waiting for %s to be %s, it is %s (%s)\n", database, condition.state, status, time.Since(start).Round(time.Second))
+
+		interval *= 2
+		if interval > maxWaitInterval {
+			interval = maxWaitInterval
+		}
+	}
+}
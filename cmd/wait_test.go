@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+func TestWaitForWorkspace(t *testing.T) {
+	savedInterval, savedMax := waitInterval, maxWaitInterval
+	waitInterval, maxWaitInterval = time.Millisecond, 5*time.Millisecond
+	defer func() { waitInterval, maxWaitInterval = savedInterval, savedMax }()
+
+	tests := []struct {
+		name      string
+		statuses  []string
+		condition waitCondition
+		timeout   string
+		code      int
+		wantErr   string
+	}{
+		{"reaches the state", []string{"Active", "Pausing", "Paused"}, workspaceIn(objects.StatusPaused), "1m", 0, ""},
+		{"deleted", []string{"Active", ""}, workspaceGone(), "1m", 0, ""},
+		{"failed", []string{"Creating", "Failed"}, workspaceIn(objects.StatusActive), "1m", exitGeneral, "failed to become Active"},
+		{"timeout", []string{"Pausing"}, workspaceIn(objects.StatusPaused), "20ms", exitTimeout, "timed out"},
+		{"restarted", []string{"Active", "Restarting", "Active"}, workspaceRestarted(objects.CMClusterInfo{Status: objects.StatusActive}), "1m", 0, ""},
+		{"restart not begun", []string{"Active"}, workspaceRestarted(objects.CMClusterInfo{Status: objects.StatusActive}), "20ms", exitTimeout, "to be restarted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls := 0
+			withServer(t, "v0.1.7", func(w http.ResponseWriter, r *http.Request) {
+				status := tt.statuses[len(tt.statuses)-1]
+				if polls < len(tt.statuses) {
+					status = tt.statuses[polls]
+				}
+				polls++
+				if len(status) == 0 {
+					fmt.Fprint(w, `{"clusters":[]}`)
+					return
+				}
+				fmt.Fprintf(w, `{"clusters":[{"dcosAppId":"splicedb","status":%q}]}`, status)
+			})
+
+			cmd := &cobra.Command{Use: "test"}
+			addWaitFlags(cmd)
+			cmd.Flags().Set("wait", "true")
+			cmd.Flags().Set("timeout", tt.timeout)
+
+			err := waitForWorkspace(cmd, "splicedb", tt.condition)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected an error containing %q, got: %v", tt.wantErr, err)
+			}
+			if code := exitCode(err); code != tt.code {
+				t.Fatalf("expected exit code %d, got: %d", tt.code, code)
+			}
+		})
+	}
+}
+
+func TestWaitForWorkspaceWithoutWait(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	addWaitFlags(cmd)
+	if err := waitForWorkspace(cmd, "splicedb", workspaceIn(objects.StatusActive)); err != nil {
+		t.Fatalf("expected no error without --wait, got: %v", err)
+	}
+}
+
+func TestWorkspaceRestartedUpdatedAt(t *testing.T) {
+	before := objects.CMClusterInfo{Status: objects.StatusActive, UpdatedAt: "2021-03-01T12:00:00Z"}
+	condition := workspaceRestarted(before)
+	if condition.done(before, true) {
+		t.Fatal("expected the restart not to have begun")
+	}
+	after := objects.CMClusterInfo{Status: objects.StatusActive, UpdatedAt: "2021-03-01T12:05:00Z"}
+	if !condition.done(after, true) {
+		t.Fatal("expected a newer UpdatedAt to finish the restart")
+	}
+}
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/printer"
+)
+
+// defaultWatchInterval - how often --watch polls when --interval isn't given
+const defaultWatchInterval = 5 * time.Second
+
+// The ANSI sequences redrawing the table in place and highlighting the rows
+// that changed
+const (
+	clearScreen    = "\033[H\033[2J"
+	highlightStart = "\033[1;33m"
+	highlightEnd   = "\033[0m"
+)
+
+// watchState - the result of one poll of --watch, the table printed, and
+// the status of each row keyed by the name of the row
+type watchState struct {
+	table    printer.Table
+	names    []string
+	statuses map[string]string
+}
+
+// watchFetch - poll the API server once
+type watchFetch func() (watchState, error)
+
+// addWatchFlags - the --watch and --interval flags of the commands that can
+// be watched
+func addWatchFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolP("watch", "w", false, "Poll the API server and redraw the output, -o json prints one change event per line")
+	cmd.Flags().Duration("interval", defaultWatchInterval, "How often --watch polls, ie: 10s or 1m")
+}
+
+// watching - --watch was given
+func watching(cmd *cobra.Command) bool {
+	watch, _ := cmd.Flags().GetBool("watch")
+	return watch
+}
+
+// runWatch - poll fetch every --interval until interrupted, printing the
+// table or, with -o json, the change events
+func runWatch(cmd *cobra.Command, fetch watchFetch) error {
+	interval, _ := cmd.Flags().GetDuration("interval")
+	if interval <= 0 {
+		return errors.New("--interval must be greater than zero")
+	}
+	format, _ := printer.SplitFormat(selectedFormat("table"))
+	switch format {
+	case "table", "text", "wide", "json":
+	default:
+		return fmt.Errorf("--watch prints the table, wide or json output formats, not %s", format)
+	}
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(stop)
+	return watchLoop(os.Stdout, format, isTerminal(os.Stdout), interval, fetch, stop)
+}
+
+// watchLoop - the polling of runWatch, returns once stop receives
+func watchLoop(w io.Writer, format string, terminal bool, interval time.Duration, fetch watchFetch, stop <-chan os.Signal) error {
+	var previous map[string]string
+	var previousNames []string
+	for {
+		state, err := fetch()
+		if err != nil {
+			return err
+		}
+		if format == "json" {
+			encoder := json.NewEncoder(w)
+			for _, event := range objects.WatchEvents(previous, previousNames, state.statuses, state.names, time.Now()) {
+				if err := encoder.Encode(event); err != nil {
+					return withMessage(err, "Error writing output")
+				}
+			}
+		} else if err := drawWatch(w, format, terminal, interval, state, previous); err != nil {
+			return err
+		}
+		previous, previousNames = state.statuses, state.names
+
+		select {
+		case <-stop:
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// drawWatch - print the table of state.  On a terminal the screen is
+// cleared first and the rows whose status changed since the previous poll
+// are highlighted.
+func drawWatch(w io.Writer, format string, terminal bool, interval time.Duration, state watchState, previous map[string]string) error {
+	table := state.table
+	if terminal {
+		fmt.Fprint(w, clearScreen)
+		fmt.Fprintf(w, "Every %s: %s\n\n", interval, time.Now().Format(time.RFC1123))
+		if previous != nil {
+			table.Rows = make([][]string, len(state.table.Rows))
+			for i, row := range state.table.Rows {
+				table.Rows[i] = row
+				if i >= len(state.names) {
+					continue
+				}
+				name := state.names[i]
+				if old, seen := previous[name]; !seen || old != state.statuses[name] {
+					table.Rows[i] = highlightRow(row)
+				}
+			}
+		}
+	}
+	opts := printer.Options{NoHeaders: noHeaders, Wide: format == "wide"}
+	if err := printer.WriteTable(w, table, opts); err != nil {
+		return withMessage(err, "Error writing output")
+	}
+	if !terminal {
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// highlightRow - a copy of row with every cell highlighted
+func highlightRow(row []string) []string {
+	highlighted := make([]string, len(row))
+	for i, cell := range row {
+		highlighted[i] = highlightStart + cell + highlightEnd
+	}
+	return highlighted
+}
+
+// isTerminal - f is a terminal rather than a file or a pipe
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/splicemachine/splicectl/cmd/objects"
+	"github.com/splicemachine/splicectl/printer"
+)
+
+func TestWatchLoopJSON(t *testing.T) {
+	statuses := []string{"Active", "Active", "Pausing"}
+	polls := 0
+	withServer(t, "v0.1.7", func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[len(statuses)-1]
+		if polls < len(statuses) {
+			status = statuses[polls]
+		}
+		polls++
+		fmt.Fprintf(w, `{"clusters":[{"dcosAppId":"splicedb","status":%q}]}`, status)
+	})
+
+	stop := make(chan os.Signal, 1)
+	fetch := watchDatabaseList(false, false, objects.WorkspaceFilter{}, "")
+	stopping := func() (watchState, error) {
+		if polls == len(statuses)-1 {
+			stop <- os.Interrupt
+		}
+		return fetch()
+	}
+	var out bytes.Buffer
+	if err := watchLoop(&out, "json", false, time.Millisecond, stopping, stop); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected an added and a changed event, got: %q", out.String())
+	}
+	var event objects.WatchEvent
+	if err := json.Unmarshal([]byte(lines[1]), &event); err != nil {
+		t.Fatalf("expected a JSON event, got: %s", lines[1])
+	}
+	if event.Type != objects.WatchChanged || event.Status != "Pausing" || event.Previous != "Active" {
+		t.Fatalf("expected splicedb to change to Pausing, got: %+v", event)
+	}
+}
+
+func TestDrawWatchHighlight(t *testing.T) {
+	table := printer.NewTable("DATABASE", "STATUS")
+	table.AddRow("splicedb", "Pausing")
+	state := watchState{
+		table:    table,
+		names:    []string{"splicedb"},
+		statuses: map[string]string{"splicedb": "Pausing"},
+	}
+	var out bytes.Buffer
+	if err := drawWatch(&out, "table", true, time.Second, state, map[string]string{"splicedb": "Active"}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), clearScreen) || !strings.Contains(out.String(), highlightStart+"Pausing"+highlightEnd) {
+		t.Fatalf("expected the changed row to be highlighted, got: %q", out.String())
+	}
+
+	out.Reset()
+	if err := drawWatch(&out, "table", false, time.Second, state, map[string]string{"splicedb": "Active"}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if strings.Contains(out.String(), "\033") {
+		t.Fatalf("expected no escape sequences when not on a terminal, got: %q", out.String())
+	}
+}
@@ -2,8 +2,10 @@ package common
 
 import (
 	"encoding/json"
+	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -101,3 +103,51 @@ func DatabaseName(cmd *cobra.Command) string {
 	}
 	return prefName
 }
+
+// DatabaseNames - the names given with the repeatable --database-name flag,
+// for the commands acting on several workspaces.  The --workspace and
+// --database aliases keep the precedence of DatabaseName, they name a single
+// workspace and are only used when --database-name isn't given.
+func DatabaseNames(cmd *cobra.Command) []string {
+	names, _ := cmd.Flags().GetStringArray("database-name")
+	workspace, _ := cmd.Flags().GetString("workspace")
+	db, _ := cmd.Flags().GetString("database")
+	supplied := names
+	for _, name := range []string{workspace, db} {
+		if name != "" {
+			supplied = append(supplied, name)
+		}
+	}
+	if len(supplied) == 0 {
+		return nil
+	}
+	if len(names) == 0 {
+		names = supplied[:1]
+	}
+	if len(supplied) > len(names) {
+		logrus.Warn("multiple flags were supplied of [database-name|workspace|database], but this command may not use the expected name if multiple names are supplied")
+		logrus.Warnf("this command will use: %s", strings.Join(names, ", "))
+	}
+	return names
+}
+
+// ParseVersionRange - parse the two versions of a FROM..TO range, ie: 7..9.
+// When TO is left off, ie: 7.., it is returned as 0, which is the latest
+// version.
+func ParseVersionRange(spec string) (int, int, error) {
+	parts := strings.Split(spec, "..")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("version range %q must be in the form FROM..TO, ie: 7..9", spec)
+	}
+	from, err := strconv.Atoi(parts[0])
+	if err != nil || from < 1 {
+		return 0, 0, fmt.Errorf("version range %q has an invalid FROM version", spec)
+	}
+	to := 0
+	if len(parts[1]) > 0 {
+		if to, err = strconv.Atoi(parts[1]); err != nil || to < 1 {
+			return 0, 0, fmt.Errorf("version range %q has an invalid TO version", spec)
+		}
+	}
+	return from, to, nil
+}
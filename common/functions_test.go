@@ -59,3 +59,49 @@ func testDatabaseNameWithArray(t *testing.T, args []string) {
 	tcmd.SetArgs(args)
 	tcmd.Execute()
 }
+
+func TestParseVersionRange(t *testing.T) {
+	for spec, expected := range map[string][2]int{
+		"7..9": {7, 9},
+		"9..7": {9, 7},
+		"3..":  {3, 0},
+	} {
+		from, to, err := ParseVersionRange(spec)
+		if err != nil {
+			t.Fatalf("expected no error for %s, got: %v", spec, err)
+		}
+		if from != expected[0] || to != expected[1] {
+			t.Errorf("expected %s to be %d..%d, got: %d..%d", spec, expected[0], expected[1], from, to)
+		}
+	}
+	for _, spec := range []string{"", "7", "..9", "a..9", "7..b", "0..2", "1..2..3"} {
+		if _, _, err := ParseVersionRange(spec); err == nil {
+			t.Errorf("expected an error for %q", spec)
+		}
+	}
+}
+
+func TestDatabaseNames(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-d", "splicedb", "--database-name", "dev-*", "--workspace", "other"}, "[splicedb dev-*]"},
+		{[]string{"--workspace", "other", "--database", "db"}, "[other]"},
+		{[]string{"--database", "db"}, "[db]"},
+		{nil, "[]"},
+	}
+	for _, tt := range tests {
+		tcmd := &cobra.Command{Use: "test", Run: func(cmd *cobra.Command, args []string) {}}
+		tcmd.Flags().StringArrayP("database-name", "d", nil, "")
+		tcmd.Flags().String("database", "", "")
+		tcmd.Flags().String("workspace", "", "")
+		tcmd.SetArgs(tt.args)
+		if err := tcmd.Execute(); err != nil {
+			t.Fatal(err)
+		}
+		if names := DatabaseNames(tcmd); fmt.Sprint(names) != tt.want {
+			t.Fatalf("%v: expected %s, got: %v", tt.args, tt.want, names)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/splicemachine/splicectl/cmd/objects"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// manifestExtensions - the files read when a directory is given to ReadManifests
+var manifestExtensions = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+}
+
+// ReadManifests - read the manifests in a file, or in every .yaml, .yml and
+// .json file of a directory in name order.  A path of "-" reads stdin.
+func ReadManifests(path string) ([]objects.Manifest, error) {
+	if path == "-" {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		return ParseManifests(data, "stdin")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		files = files[:0]
+		for _, entry := range entries {
+			if !entry.IsDir() && manifestExtensions[strings.ToLower(filepath.Ext(entry.Name()))] {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+		sort.Strings(files)
+	}
+
+	var manifests []objects.Manifest
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		fileManifests, err := ParseManifests(data, file)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, fileManifests...)
+	}
+	return manifests, nil
+}
+
+// ParseManifests - parse the YAML documents, separated by '---', or the
+// single JSON document in data.  source is used in error messages.
+func ParseManifests(data []byte, source string) ([]objects.Manifest, error) {
+	var manifests []objects.Manifest
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for doc := 1; ; doc++ {
+		raw, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", source, err)
+		}
+		manifest, err := parseManifest(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s, document %d: %v", source, doc, err)
+		}
+		// Empty documents, ie: a leading '---', are skipped
+		if manifest == nil {
+			continue
+		}
+		manifest.Source = source
+		manifests = append(manifests, *manifest)
+	}
+	return manifests, nil
+}
+
+func parseManifest(raw []byte) (*objects.Manifest, error) {
+	jsonBytes, err := WantJSON(raw)
+	if err != nil {
+		return nil, fmt.Errorf("the document MUST be in either JSON or YAML format: %v", err)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(jsonBytes, &body); err != nil {
+		return nil, fmt.Errorf("the document must be an object: %v", err)
+	}
+	if body == nil {
+		return nil, nil
+	}
+
+	var manifest objects.Manifest
+	if err := json.Unmarshal(jsonBytes, &manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Kind, err = objects.NormalizeKind(manifest.Kind); err != nil {
+		return nil, err
+	}
+	delete(body, "kind")
+	delete(body, "metadata")
+	manifest.Body = body
+
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/splicemachine/splicectl/cmd/objects"
+)
+
+const multiDocManifest = `---
+kind: DefaultCR
+data:
+  key: value
+---
+kind: databasecr
+metadata:
+  database-name: splicedb
+  expected-version: 4
+data:
+  key: value
+---
+# only a comment
+---
+kind: ImageTag
+metadata:
+  database-name: splicedb
+  component: hbase
+tag: master-246
+`
+
+func TestParseManifests(t *testing.T) {
+	manifests, err := ParseManifests([]byte(multiDocManifest), "test.yaml")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(manifests) != 3 {
+		t.Fatalf("expected 3 manifests, got: %d", len(manifests))
+	}
+
+	dbCR := manifests[1]
+	if dbCR.Kind != objects.KindDatabaseCR || dbCR.Metadata.DatabaseName != "splicedb" || dbCR.Metadata.ExpectedVersion != 4 {
+		t.Fatalf("unexpected manifest: %+v", dbCR)
+	}
+	if _, ok := dbCR.Body["kind"]; ok {
+		t.Fatalf("expected kind to be removed from the body, got: %v", dbCR.Body)
+	}
+	if _, ok := dbCR.Body["data"]; !ok {
+		t.Fatalf("expected data to be kept in the body, got: %v", dbCR.Body)
+	}
+	if manifests[2].Body["tag"] != "master-246" || manifests[2].Source != "test.yaml" {
+		t.Fatalf("unexpected manifest: %+v", manifests[2])
+	}
+}
+
+func TestParseManifestsErrors(t *testing.T) {
+	for _, doc := range []string{
+		"kind: Unknown\ndata: {}",
+		"data: {}",
+		"kind: DatabaseCR\ndata: {}",
+		"kind: CMSettings\nmetadata:\n  component: db\ndata: {}",
+		"kind: VaultKey\ndata: {}",
+		"kind: ImageTag\nmetadata:\n  database-name: splicedb\n  component: hbase",
+		"kind: ImageTag\nmetadata:\n  database-name: splicedb\n  component: hbase\n  expected-version: 2\ntag: master-246",
+		"kind: DefaultCR\nmetadata:\n  expected-version: -1\ndata: {}",
+		"- a list",
+	} {
+		if _, err := ParseManifests([]byte(doc), "test.yaml"); err == nil {
+			t.Errorf("expected an error for: %s", doc)
+		}
+	}
+}
+
+func TestReadManifestsDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"b.yaml":     "kind: SystemSettings\ndata:\n  KEY: value\n",
+		"a.json":     `{"kind": "VaultKey", "metadata": {"keypath": "secrets/key"}, "data": {}}`,
+		"readme.txt": "not a manifest",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	manifests, err := ReadManifests(dir)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(manifests) != 2 {
+		t.Fatalf("expected 2 manifests, got: %d", len(manifests))
+	}
+	if manifests[0].Kind != objects.KindVaultKey || manifests[1].Kind != objects.KindSystemSettings {
+		t.Fatalf("expected the files to be read in name order, got: %s, %s", manifests[0].Kind, manifests[1].Kind)
+	}
+}
@@ -1,7 +1,22 @@
 package common
 
+import "time"
+
 // SessionData - Session Authorization Info
 type SessionData struct {
 	SessionID  string `json:"session_id"`
 	ValidUntil string `json:"valid_until"`
 }
+
+// Remaining - how long until the session expires, 0 when it has expired or
+// ValidUntil isn't an RFC3339 time
+func (s SessionData) Remaining() time.Duration {
+	notAfter, err := time.Parse(time.RFC3339, s.ValidUntil)
+	if err != nil {
+		return 0
+	}
+	if remaining := time.Until(notAfter); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
@@ -0,0 +1,18 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSessionRemaining(t *testing.T) {
+	session := SessionData{SessionID: "abc", ValidUntil: time.Now().Add(time.Hour).UTC().Format(time.RFC3339)}
+	if remaining := session.Remaining(); remaining < 59*time.Minute || remaining > time.Hour {
+		t.Errorf("expected about an hour, got: %v", remaining)
+	}
+	for _, validUntil := range []string{"", "%!s(<nil>)", time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)} {
+		if remaining := (SessionData{ValidUntil: validUntil}).Remaining(); remaining != 0 {
+			t.Errorf("expected no time remaining for %q, got: %v", validUntil, remaining)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Package diff computes a structural, path aware diff between two JSON
+// documents, ie: the value stored in Vault and the file about to be applied.
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+
+	"github.com/fatih/color"
+)
+
+// ChangeType - how a value differs between the two documents
+type ChangeType string
+
+// The types of change reported by Compare
+const (
+	Added    ChangeType = "added"
+	Removed  ChangeType = "removed"
+	Modified ChangeType = "modified"
+)
+
+// Change - a single difference, Path is a jsonpath like .data.spec[0].name
+type Change struct {
+	Path    string      `json:"path"`
+	Type    ChangeType  `json:"type"`
+	Current interface{} `json:"current,omitempty"`
+	Desired interface{} `json:"desired,omitempty"`
+}
+
+// CompareJSON - decode both JSON documents and compare them
+func CompareJSON(current []byte, desired []byte) ([]Change, error) {
+	var currentValue, desiredValue interface{}
+	if err := json.Unmarshal(current, &currentValue); err != nil {
+		return nil, fmt.Errorf("could not decode the current value: %v", err)
+	}
+	if err := json.Unmarshal(desired, &desiredValue); err != nil {
+		return nil, fmt.Errorf("could not decode the desired value: %v", err)
+	}
+	return Compare(currentValue, desiredValue), nil
+}
+
+// Compare - list the changes needed to turn current in to desired, both are
+// expected to hold the maps and slices produced by decoding JSON.  Changes
+// are ordered by path.
+func Compare(current interface{}, desired interface{}) []Change {
+	var changes []Change
+	compare("", current, desired, &changes)
+	return changes
+}
+
+func compare(path string, current interface{}, desired interface{}, changes *[]Change) {
+	switch c := current.(type) {
+	case map[string]interface{}:
+		if d, ok := desired.(map[string]interface{}); ok {
+			compareMaps(path, c, d, changes)
+			return
+		}
+	case []interface{}:
+		if d, ok := desired.([]interface{}); ok {
+			compareSlices(path, c, d, changes)
+			return
+		}
+	}
+	if !reflect.DeepEqual(current, desired) {
+		*changes = append(*changes, Change{Path: rootPath(path), Type: Modified, Current: current, Desired: desired})
+	}
+}
+
+func compareMaps(path string, current map[string]interface{}, desired map[string]interface{}, changes *[]Change) {
+	keys := make([]string, 0, len(current)+len(desired))
+	for k := range current {
+		keys = append(keys, k)
+	}
+	for k := range desired {
+		if _, ok := current[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		c, inCurrent := current[k]
+		d, inDesired := desired[k]
+		keyPath := path + pathKey(k)
+		switch {
+		case !inCurrent:
+			*changes = append(*changes, Change{Path: keyPath, Type: Added, Desired: d})
+		case !inDesired:
+			*changes = append(*changes, Change{Path: keyPath, Type: Removed, Current: c})
+		default:
+			compare(keyPath, c, d, changes)
+		}
+	}
+}
+
+func compareSlices(path string, current []interface{}, desired []interface{}, changes *[]Change) {
+	for i := 0; i < len(current) || i < len(desired); i++ {
+		indexPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= len(current):
+			*changes = append(*changes, Change{Path: indexPath, Type: Added, Desired: desired[i]})
+		case i >= len(desired):
+			*changes = append(*changes, Change{Path: indexPath, Type: Removed, Current: current[i]})
+		default:
+			compare(indexPath, current[i], desired[i], changes)
+		}
+	}
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// pathKey - keys that aren't simple identifiers, ie: contain dots, are quoted
+func pathKey(k string) string {
+	if identifier.MatchString(k) {
+		return "." + k
+	}
+	return fmt.Sprintf("[%q]", k)
+}
+
+func rootPath(path string) string {
+	if len(path) == 0 {
+		return "."
+	}
+	return path
+}
+
+// Write - print the changes, one per line, colored when w is a terminal.
+// Lines start with + for added, - for removed and ~ for modified values,
+// ie: ~ .data.image: "splice:1" => "splice:2"
+func Write(w io.Writer, changes []Change) error {
+	added := color.New(color.FgGreen)
+	removed := color.New(color.FgRed)
+	modified := color.New(color.FgYellow)
+	for _, change := range changes {
+		var err error
+		switch change.Type {
+		case Added:
+			_, err = added.Fprintf(w, "+ %s: %s\n", change.Path, compact(change.Desired))
+		case Removed:
+			_, err = removed.Fprintf(w, "- %s: %s\n", change.Path, compact(change.Current))
+		case Modified:
+			_, err = modified.Fprintf(w, "~ %s: %s => %s\n", change.Path, compact(change.Current), compact(change.Desired))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compact(v interface{}) string {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(out)
+}
@@ -0,0 +1,74 @@
+package diff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+const (
+	currentDoc = `{"data":{"image":"splice:1","replicas":3,"env":["A","B"],"old":true,"dotted.key":"x"}}`
+	desiredDoc = `{"data":{"image":"splice:2","replicas":3,"env":["A"],"new":{"nested":1},"dotted.key":"y"}}`
+)
+
+func TestCompareJSON(t *testing.T) {
+	changes, err := CompareJSON([]byte(currentDoc), []byte(desiredDoc))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	expected := []struct {
+		path       string
+		changeType ChangeType
+	}{
+		{`.data["dotted.key"]`, Modified},
+		{".data.env[1]", Removed},
+		{".data.image", Modified},
+		{".data.new", Added},
+		{".data.old", Removed},
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got: %+v", len(expected), changes)
+	}
+	for i, e := range expected {
+		if changes[i].Path != e.path || changes[i].Type != e.changeType {
+			t.Errorf("expected %s %s, got: %s %s", e.changeType, e.path, changes[i].Type, changes[i].Path)
+		}
+	}
+}
+
+func TestCompareEqual(t *testing.T) {
+	changes, err := CompareJSON([]byte(currentDoc), []byte(currentDoc))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no changes, got: %+v", changes)
+	}
+}
+
+func TestCompareTypeChange(t *testing.T) {
+	changes := Compare(map[string]interface{}{"a": "text"}, map[string]interface{}{"a": []interface{}{"text"}})
+	if len(changes) != 1 || changes[0].Type != Modified || changes[0].Path != ".a" {
+		t.Fatalf("expected a modified .a, got: %+v", changes)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	color.NoColor = true
+	var buf bytes.Buffer
+	changes, _ := CompareJSON([]byte(`{"a":1,"b":2}`), []byte(`{"a":2,"c":3}`))
+	if err := Write(&buf, changes); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected := strings.Join([]string{
+		"~ .a: 1 => 2",
+		"- .b: 2",
+		"+ .c: 3",
+	}, "\n") + "\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got: %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,54 @@
+// Package editor opens content in the user's editor and returns the result,
+// ie: the round trip behind splicectl edit.
+package editor
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// DefaultEditor - used when neither SPLICECTL_EDITOR nor EDITOR are set
+const DefaultEditor = "vi"
+
+// Command - the editor to launch, from SPLICECTL_EDITOR, then EDITOR, then
+// DefaultEditor.  The value may contain arguments, ie: "code --wait".
+func Command() []string {
+	for _, env := range []string{"SPLICECTL_EDITOR", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(env)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{DefaultEditor}
+}
+
+// Edit - write content to a temporary file with the given suffix, ie: .yaml,
+// open it in the editor and return what was saved.
+func Edit(content []byte, suffix string) ([]byte, error) {
+	file, err := ioutil.TempFile("", "splicectl-edit-*"+suffix)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+
+	args := append(Command(), file.Name())
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("editor %s failed: %v", args[0], err)
+	}
+
+	return ioutil.ReadFile(file.Name())
+}
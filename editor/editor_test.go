@@ -0,0 +1,41 @@
+package editor
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestCommand(t *testing.T) {
+	os.Setenv("SPLICECTL_EDITOR", "")
+	os.Setenv("EDITOR", "")
+	if cmd := Command(); !reflect.DeepEqual(cmd, []string{DefaultEditor}) {
+		t.Errorf("expected the default editor, got: %v", cmd)
+	}
+
+	os.Setenv("EDITOR", "nano")
+	if cmd := Command(); !reflect.DeepEqual(cmd, []string{"nano"}) {
+		t.Errorf("expected EDITOR to be used, got: %v", cmd)
+	}
+
+	os.Setenv("SPLICECTL_EDITOR", "code --wait")
+	if cmd := Command(); !reflect.DeepEqual(cmd, []string{"code", "--wait"}) {
+		t.Errorf("expected SPLICECTL_EDITOR to be preferred, got: %v", cmd)
+	}
+	os.Setenv("SPLICECTL_EDITOR", "")
+	os.Setenv("EDITOR", "")
+}
+
+func TestEdit(t *testing.T) {
+	// sed stands in for an interactive editor
+	os.Setenv("SPLICECTL_EDITOR", "sed -i s/before/after/")
+	defer os.Setenv("SPLICECTL_EDITOR", "")
+
+	out, err := Edit([]byte("key: before\n"), ".yaml")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if string(out) != "key: after\n" {
+		t.Fatalf("expected the edited content, got: %q", out)
+	}
+}
@@ -5,6 +5,7 @@ go 1.14
 require (
 	github.com/AlecAivazis/survey/v2 v2.1.1
 	github.com/blang/semver/v4 v4.0.0
+	github.com/fatih/color v1.10.0
 	github.com/go-resty/resty/v2 v2.2.0
 	github.com/imdario/mergo v0.3.9 // indirect
 	github.com/maahsome/gron v0.1.0
@@ -15,9 +16,10 @@ require (
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.6.3
 	github.com/stretchr/testify v1.4.0
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
 	gopkg.in/yaml.v2 v2.2.8
-	k8s.io/apimachinery v0.18.4
-	k8s.io/client-go v0.18.4
-	k8s.io/utils v0.0.0-20200414100711-2df71ebbae66 // indirect
+	k8s.io/api v0.19.16
+	k8s.io/apimachinery v0.19.16
+	k8s.io/client-go v0.19.16
 	sigs.k8s.io/yaml v1.2.0
 )
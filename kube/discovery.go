@@ -0,0 +1,206 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// APIName - the name of the Ingress, Route and Service of splicectl-api
+const APIName = "splicectl-api"
+
+// ServiceSelector - the label selecting the splicectl-api Service when no
+// Ingress or Route exists
+const ServiceSelector = "app.kubernetes.io/name=" + APIName
+
+// RouteResource - the OpenShift Route API
+var RouteResource = schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+
+// Discovery - where the API server was found
+type Discovery struct {
+	// Server - the base URI, ie: https://splicectl-api.example.com
+	Server string
+	// Source - the object it was read from, ie: networking.k8s.io/v1 Ingress splice-system/splicectl-api
+	Source string
+}
+
+// DiscoveryError - the API server could not be found, Attempts describes
+// each place that was looked at and why it didn't work.
+type DiscoveryError struct {
+	Attempts []string
+}
+
+func (e *DiscoveryError) Error() string {
+	return fmt.Sprintf("could not discover the splicectl API server, tried:\n  %s", strings.Join(e.Attempts, "\n  "))
+}
+
+// DiscoverServer - find the API server in namespace, trying in order the
+// networking.k8s.io/v1 Ingress, the extensions/v1beta1 Ingress, a Service
+// labeled ServiceSelector with an external address and the OpenShift Route.
+// dynamicClient may be nil, the Route is then skipped.
+func DiscoverServer(ctx context.Context, clientset kubernetes.Interface, dynamicClient dynamic.Interface, namespace string) (Discovery, error) {
+	discoverErr := &DiscoveryError{}
+	steps := []struct {
+		source string
+		find   func() (string, error)
+	}{
+		{"networking.k8s.io/v1 Ingress", func() (string, error) { return ingressV1Server(ctx, clientset, namespace) }},
+		{"extensions/v1beta1 Ingress", func() (string, error) { return ingressV1beta1Server(ctx, clientset, namespace) }},
+		{"Service " + ServiceSelector, func() (string, error) { return serviceServer(ctx, clientset, namespace) }},
+		{"route.openshift.io/v1 Route", func() (string, error) { return routeServer(ctx, dynamicClient, namespace) }},
+	}
+	for _, step := range steps {
+		source := fmt.Sprintf("%s %s/%s", step.source, namespace, APIName)
+		server, err := step.find()
+		if err == nil {
+			return Discovery{Server: server, Source: source}, nil
+		}
+		discoverErr.Attempts = append(discoverErr.Attempts, fmt.Sprintf("%s: %v", source, err))
+	}
+	return Discovery{}, discoverErr
+}
+
+func ingressV1Server(ctx context.Context, clientset kubernetes.Interface, namespace string) (string, error) {
+	ingress, err := clientset.NetworkingV1().Ingresses(namespace).Get(ctx, APIName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	var hosts []string
+	for _, rule := range ingress.Spec.Rules {
+		hosts = append(hosts, rule.Host)
+	}
+	var tlsHosts [][]string
+	for _, tls := range ingress.Spec.TLS {
+		tlsHosts = append(tlsHosts, tls.Hosts)
+	}
+	return ingressServer(hosts, tlsHosts, ingress.Status.LoadBalancer.Ingress)
+}
+
+func ingressV1beta1Server(ctx context.Context, clientset kubernetes.Interface, namespace string) (string, error) {
+	ingress, err := clientset.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, APIName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	var hosts []string
+	for _, rule := range ingress.Spec.Rules {
+		hosts = append(hosts, rule.Host)
+	}
+	var tlsHosts [][]string
+	for _, tls := range ingress.Spec.TLS {
+		tlsHosts = append(tlsHosts, tls.Hosts)
+	}
+	return ingressServer(hosts, tlsHosts, ingress.Status.LoadBalancer.Ingress)
+}
+
+// ingressServer - the URI of the first rule with a host, or the load
+// balancer address when no rule names a host.  https is used when a TLS
+// block covers the host, a TLS block without hosts covers them all.
+func ingressServer(hosts []string, tlsHosts [][]string, loadBalancer []corev1.LoadBalancerIngress) (string, error) {
+	host := ""
+	for _, h := range hosts {
+		if len(h) > 0 {
+			host = h
+			break
+		}
+	}
+	if len(host) == 0 {
+		host = loadBalancerAddress(loadBalancer)
+	}
+	if len(host) == 0 {
+		return "", fmt.Errorf("has no rule with a host and no load balancer address")
+	}
+
+	scheme := "http"
+	for _, tls := range tlsHosts {
+		if len(tls) == 0 || contains(tls, host) {
+			scheme = "https"
+		}
+	}
+	return fmt.Sprintf("%s://%s", scheme, host), nil
+}
+
+func serviceServer(ctx context.Context, clientset kubernetes.Interface, namespace string) (string, error) {
+	services, err := clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{LabelSelector: ServiceSelector})
+	if err != nil {
+		return "", err
+	}
+	if len(services.Items) == 0 {
+		return "", fmt.Errorf("not found")
+	}
+
+	service := services.Items[0]
+	host := loadBalancerAddress(service.Status.LoadBalancer.Ingress)
+	if len(host) == 0 && len(service.Spec.ExternalIPs) > 0 {
+		host = service.Spec.ExternalIPs[0]
+	}
+	if len(host) == 0 {
+		return "", fmt.Errorf("service %s has no load balancer or external address", service.Name)
+	}
+	if len(service.Spec.Ports) == 0 {
+		return "", fmt.Errorf("service %s has no ports", service.Name)
+	}
+
+	// prefer a port named https, or 443
+	port := service.Spec.Ports[0]
+	for _, p := range service.Spec.Ports {
+		if p.Name == "https" || p.Port == 443 {
+			port = p
+			break
+		}
+	}
+	switch {
+	case port.Port == 443:
+		return fmt.Sprintf("https://%s", host), nil
+	case port.Name == "https":
+		return fmt.Sprintf("https://%s:%d", host, port.Port), nil
+	case port.Port == 80:
+		return fmt.Sprintf("http://%s", host), nil
+	}
+	return fmt.Sprintf("http://%s:%d", host, port.Port), nil
+}
+
+func routeServer(ctx context.Context, dynamicClient dynamic.Interface, namespace string) (string, error) {
+	if dynamicClient == nil {
+		return "", fmt.Errorf("no dynamic client")
+	}
+	route, err := dynamicClient.Resource(RouteResource).Namespace(namespace).Get(ctx, APIName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+	if len(host) == 0 {
+		return "", fmt.Errorf("has no host")
+	}
+	if _, hasTLS, _ := unstructured.NestedMap(route.Object, "spec", "tls"); hasTLS {
+		return fmt.Sprintf("https://%s", host), nil
+	}
+	return fmt.Sprintf("http://%s", host), nil
+}
+
+func loadBalancerAddress(loadBalancer []corev1.LoadBalancerIngress) string {
+	for _, lb := range loadBalancer {
+		if len(lb.Hostname) > 0 {
+			return lb.Hostname
+		}
+		if len(lb.IP) > 0 {
+			return lb.IP
+		}
+	}
+	return ""
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var apiMeta = metav1.ObjectMeta{Name: APIName, Namespace: DefaultNamespace}
+
+func discover(t *testing.T, objects []runtime.Object, route *unstructured.Unstructured) (Discovery, error) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	var dynamicObjects []runtime.Object
+	if route != nil {
+		dynamicObjects = append(dynamicObjects, route)
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, dynamicObjects...)
+	return DiscoverServer(context.TODO(), fake.NewSimpleClientset(objects...), dynamicClient, DefaultNamespace)
+}
+
+func TestDiscoverIngressV1(t *testing.T) {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: apiMeta,
+		Spec: networkingv1.IngressSpec{
+			TLS:   []networkingv1.IngressTLS{{Hosts: []string{"api.example.com"}}},
+			Rules: []networkingv1.IngressRule{{Host: ""}, {Host: "api.example.com"}},
+		},
+	}
+	found, err := discover(t, []runtime.Object{ingress}, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if found.Server != "https://api.example.com" || !strings.HasPrefix(found.Source, "networking.k8s.io/v1") {
+		t.Fatalf("unexpected discovery: %+v", found)
+	}
+}
+
+func TestDiscoverIngressWithoutTLS(t *testing.T) {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: apiMeta,
+		Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "api.example.com"}}},
+	}
+	found, err := discover(t, []runtime.Object{ingress}, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if found.Server != "http://api.example.com" {
+		t.Fatalf("expected http without a TLS block, got: %+v", found)
+	}
+}
+
+func TestDiscoverIngressV1beta1(t *testing.T) {
+	ingress := &extensionsv1beta1.Ingress{
+		ObjectMeta: apiMeta,
+		Spec: extensionsv1beta1.IngressSpec{
+			TLS:   []extensionsv1beta1.IngressTLS{{Hosts: []string{"other.example.com"}}},
+			Rules: []extensionsv1beta1.IngressRule{{Host: "api.example.com"}},
+		},
+	}
+	found, err := discover(t, []runtime.Object{ingress}, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if found.Server != "http://api.example.com" || !strings.HasPrefix(found.Source, "extensions/v1beta1") {
+		t.Fatalf("unexpected discovery: %+v", found)
+	}
+}
+
+func TestDiscoverIngressWithoutRules(t *testing.T) {
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: apiMeta,
+		Spec:       networkingv1.IngressSpec{TLS: []networkingv1.IngressTLS{{}}},
+		Status: networkingv1.IngressStatus{LoadBalancer: corev1.LoadBalancerStatus{
+			Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+		}},
+	}
+	found, err := discover(t, []runtime.Object{ingress}, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if found.Server != "https://10.0.0.1" {
+		t.Fatalf("expected the load balancer address, got: %+v", found)
+	}
+}
+
+func TestDiscoverService(t *testing.T) {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "api",
+			Namespace: DefaultNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/name": APIName},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 8080}, {Name: "https", Port: 8443}},
+		},
+		Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+			Ingress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}},
+		}},
+	}
+	found, err := discover(t, []runtime.Object{service}, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if found.Server != "https://lb.example.com:8443" {
+		t.Fatalf("unexpected discovery: %+v", found)
+	}
+}
+
+func TestDiscoverRoute(t *testing.T) {
+	route := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "route.openshift.io/v1",
+		"kind":       "Route",
+		"metadata":   map[string]interface{}{"name": APIName, "namespace": DefaultNamespace},
+		"spec": map[string]interface{}{
+			"host": "api.apps.example.com",
+			"tls":  map[string]interface{}{"termination": "edge"},
+		},
+	}}
+	found, err := discover(t, nil, route)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if found.Server != "https://api.apps.example.com" || !strings.HasPrefix(found.Source, "route.openshift.io/v1") {
+		t.Fatalf("unexpected discovery: %+v", found)
+	}
+}
+
+func TestDiscoverNothing(t *testing.T) {
+	_, err := discover(t, nil, nil)
+	var discoverErr *DiscoveryError
+	if !errors.As(err, &discoverErr) {
+		t.Fatalf("expected a DiscoveryError, got: %v", err)
+	}
+	if len(discoverErr.Attempts) != 4 {
+		t.Fatalf("expected 4 attempts, got: %v", discoverErr.Attempts)
+	}
+}
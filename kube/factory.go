@@ -0,0 +1,113 @@
+// Package kube builds the Kubernetes client splicectl uses to discover the
+// API server and read its secrets, honoring the kubeconfig, kube context and
+// namespace selected with the global flags or the config file.
+package kube
+
+import (
+	"errors"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	// This is the way
+	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+)
+
+// DefaultNamespace - the namespace splicectl-api is installed in by default
+const DefaultNamespace = "splice-system"
+
+// ErrNoKubeconfig - no kubeconfig was given and none was found in
+// $KUBECONFIG or ~/.kube/config
+var ErrNoKubeconfig = errors.New("could not locate the KUBECONFIG file, normally ~/.kube/config")
+
+// Factory - builds the Kubernetes client once and shares it between the
+// callers, ie: ingress discovery and auth.
+type Factory struct {
+	// Kubeconfig - path to the kubeconfig, $KUBECONFIG or ~/.kube/config when empty
+	Kubeconfig string
+	// Context - the kubeconfig context, the current context when empty
+	Context string
+
+	namespace     string
+	clientset     kubernetes.Interface
+	dynamicClient dynamic.Interface
+}
+
+// NewFactory - return a factory for the kubeconfig and context, namespace
+// is where splicectl-api is installed, DefaultNamespace when empty.
+func NewFactory(kubeconfig string, context string, namespace string) *Factory {
+	return &Factory{
+		Kubeconfig: kubeconfig,
+		Context:    context,
+		namespace:  namespace,
+	}
+}
+
+// NewFactoryForClientset - return a factory using existing clients, ie: the
+// fake clients in tests.
+func NewFactoryForClientset(clientset kubernetes.Interface, dynamicClient dynamic.Interface, namespace string) *Factory {
+	return &Factory{
+		namespace:     namespace,
+		clientset:     clientset,
+		dynamicClient: dynamicClient,
+	}
+}
+
+// Namespace - the namespace splicectl-api is installed in
+func (f *Factory) Namespace() string {
+	if len(f.namespace) == 0 {
+		return DefaultNamespace
+	}
+	return f.namespace
+}
+
+// RESTConfig - the client configuration for the selected kubeconfig and
+// context, ErrNoKubeconfig when there is no kubeconfig.
+func (f *Factory) RESTConfig() (*rest.Config, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = f.Kubeconfig
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: f.Context}
+
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+	if clientcmd.IsEmptyConfig(err) {
+		return nil, ErrNoKubeconfig
+	}
+	return cfg, err
+}
+
+// Clientset - the Kubernetes client, built on first use
+func (f *Factory) Clientset() (kubernetes.Interface, error) {
+	if f.clientset != nil {
+		return f.clientset, nil
+	}
+	cfg, err := f.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	f.clientset = clientset
+	return clientset, nil
+}
+
+// Dynamic - the dynamic client, for resources without a typed client such
+// as the OpenShift Route, built on first use
+func (f *Factory) Dynamic() (dynamic.Interface, error) {
+	if f.dynamicClient != nil {
+		return f.dynamicClient, nil
+	}
+	cfg, err := f.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	f.dynamicClient = dynamicClient
+	return dynamicClient, nil
+}
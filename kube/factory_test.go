@@ -0,0 +1,68 @@
+package kube
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: one
+  cluster:
+    server: https://one.example.com
+- name: two
+  cluster:
+    server: https://two.example.com
+contexts:
+- name: one
+  context:
+    cluster: one
+- name: two
+  context:
+    cluster: two
+current-context: one
+`
+
+func TestRESTConfigContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kube")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	kubeconfig := filepath.Join(dir, "config")
+	if err := ioutil.WriteFile(kubeconfig, []byte(testKubeconfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for context, server := range map[string]string{"": "https://one.example.com", "two": "https://two.example.com"} {
+		cfg, err := NewFactory(kubeconfig, context, "").RESTConfig()
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if cfg.Host != server {
+			t.Errorf("expected context %q to use %s, got: %s", context, server, cfg.Host)
+		}
+	}
+
+	if _, err := NewFactory(kubeconfig, "missing", "").RESTConfig(); err == nil {
+		t.Errorf("expected an error for a missing context")
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	if ns := NewFactory("", "", "").Namespace(); ns != DefaultNamespace {
+		t.Errorf("expected %s, got: %s", DefaultNamespace, ns)
+	}
+	factory := NewFactoryForClientset(fake.NewSimpleClientset(), nil, "splice-test")
+	if factory.Namespace() != "splice-test" {
+		t.Errorf("expected splice-test, got: %s", factory.Namespace())
+	}
+	if clientset, err := factory.Clientset(); err != nil || clientset == nil {
+		t.Errorf("expected the given clientset, got: %v", err)
+	}
+}
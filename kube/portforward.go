@@ -0,0 +1,164 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// ForwardTarget - the splicectl-api pod and port to forward to
+type ForwardTarget struct {
+	Pod    string
+	Port   int32
+	Scheme string
+}
+
+// PortForward - a running port-forward to splicectl-api, the API server is
+// reachable at URL until Close is called.
+type PortForward struct {
+	Target    ForwardTarget
+	LocalPort uint16
+	stopCh    chan struct{}
+}
+
+// URL - the local base URI of the API server, ie: http://127.0.0.1:41234
+func (p *PortForward) URL() string {
+	return fmt.Sprintf("%s://127.0.0.1:%d", p.Target.Scheme, p.LocalPort)
+}
+
+// Close - stop forwarding
+func (p *PortForward) Close() {
+	close(p.stopCh)
+}
+
+// PortForward - forward a random local port to a running splicectl-api pod,
+// the pod is found through the splicectl-api Service, or pods labeled
+// ServiceSelector when there is no Service.
+func (f *Factory) PortForward(ctx context.Context) (*PortForward, error) {
+	clientset, err := f.Clientset()
+	if err != nil {
+		return nil, err
+	}
+	target, err := FindForwardTarget(ctx, clientset, f.Namespace())
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := f.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	url := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(f.Namespace()).
+		Name(target.Pod).
+		SubResource("portforward").
+		URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	stopCh := make(chan struct{})
+	readyCh := make(chan struct{})
+	forwarder, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf(":%d", target.Port)}, stopCh, readyCh, ioutil.Discard, ioutil.Discard)
+	if err != nil {
+		return nil, err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwarder.ForwardPorts()
+	}()
+	select {
+	case err := <-errCh:
+		return nil, fmt.Errorf("port-forward to pod %s/%s failed: %v", f.Namespace(), target.Pod, err)
+	case <-readyCh:
+	case <-ctx.Done():
+		close(stopCh)
+		return nil, ctx.Err()
+	}
+
+	ports, err := forwarder.GetPorts()
+	if err != nil || len(ports) == 0 {
+		close(stopCh)
+		return nil, fmt.Errorf("port-forward to pod %s/%s has no local port: %v", f.Namespace(), target.Pod, err)
+	}
+	return &PortForward{Target: target, LocalPort: ports[0].Local, stopCh: stopCh}, nil
+}
+
+// FindForwardTarget - the running splicectl-api pod and the port it serves
+// the API on.  https is used when the port is named https or is 443.
+func FindForwardTarget(ctx context.Context, clientset kubernetes.Interface, namespace string) (ForwardTarget, error) {
+	selector := ServiceSelector
+	var servicePort *corev1.ServicePort
+	service, err := clientset.CoreV1().Services(namespace).Get(ctx, APIName, metav1.GetOptions{})
+	if err == nil && len(service.Spec.Selector) > 0 {
+		selector = labels.SelectorFromSet(service.Spec.Selector).String()
+		if len(service.Spec.Ports) > 0 {
+			servicePort = &service.Spec.Ports[0]
+			for i, p := range service.Spec.Ports {
+				if p.Name == "https" || p.Port == 443 {
+					servicePort = &service.Spec.Ports[i]
+					break
+				}
+			}
+		}
+	}
+
+	pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return ForwardTarget{}, err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		port, name := podPort(pod, servicePort)
+		if port == 0 {
+			continue
+		}
+		scheme := "http"
+		if name == "https" || port == 443 {
+			scheme = "https"
+		}
+		return ForwardTarget{Pod: pod.Name, Port: port, Scheme: scheme}, nil
+	}
+	return ForwardTarget{}, fmt.Errorf("no running splicectl-api pod with a port was found in %s matching %s", namespace, selector)
+}
+
+// podPort - the container port the service port targets, the first
+// container port when there is no service.
+func podPort(pod corev1.Pod, servicePort *corev1.ServicePort) (int32, string) {
+	for _, container := range pod.Spec.Containers {
+		for _, cp := range container.Ports {
+			if servicePort == nil {
+				return cp.ContainerPort, cp.Name
+			}
+			target := servicePort.TargetPort
+			switch {
+			case target.Type == intstr.String && target.StrVal == cp.Name:
+				return cp.ContainerPort, servicePort.Name
+			case target.Type == intstr.Int && target.IntVal == cp.ContainerPort:
+				return cp.ContainerPort, servicePort.Name
+			case target.Type == intstr.Int && target.IntVal == 0 && servicePort.Port == cp.ContainerPort:
+				return cp.ContainerPort, servicePort.Name
+			}
+		}
+	}
+	if servicePort != nil && servicePort.TargetPort.Type == intstr.Int && servicePort.TargetPort.IntVal > 0 {
+		// the container doesn't declare its ports
+		return servicePort.TargetPort.IntVal, servicePort.Name
+	}
+	return 0, ""
+}
@@ -0,0 +1,60 @@
+package kube
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func apiPod(name string, phase corev1.PodPhase, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: DefaultNamespace, Labels: labels},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{
+			Name:  "api",
+			Ports: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9090}, {Name: "web", ContainerPort: 8443}},
+		}}},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestFindForwardTargetService(t *testing.T) {
+	selector := map[string]string{"app": "api"}
+	service := &corev1.Service{
+		ObjectMeta: apiMeta,
+		Spec: corev1.ServiceSpec{
+			Selector: selector,
+			Ports:    []corev1.ServicePort{{Name: "https", Port: 443, TargetPort: intstr.FromString("web")}},
+		},
+	}
+	objects := []runtime.Object{service, apiPod("pending", corev1.PodPending, selector), apiPod("running", corev1.PodRunning, selector)}
+
+	target, err := FindForwardTarget(context.TODO(), fake.NewSimpleClientset(objects...), DefaultNamespace)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if target != (ForwardTarget{Pod: "running", Port: 8443, Scheme: "https"}) {
+		t.Fatalf("unexpected target: %+v", target)
+	}
+}
+
+func TestFindForwardTargetLabeledPod(t *testing.T) {
+	pod := apiPod("api", corev1.PodRunning, map[string]string{"app.kubernetes.io/name": APIName})
+	target, err := FindForwardTarget(context.TODO(), fake.NewSimpleClientset(pod), DefaultNamespace)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if target != (ForwardTarget{Pod: "api", Port: 9090, Scheme: "http"}) {
+		t.Fatalf("unexpected target: %+v", target)
+	}
+}
+
+func TestFindForwardTargetNoPod(t *testing.T) {
+	if _, err := FindForwardTarget(context.TODO(), fake.NewSimpleClientset(), DefaultNamespace); err == nil {
+		t.Fatalf("expected an error when there is no pod")
+	}
+}
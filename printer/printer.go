@@ -0,0 +1,147 @@
+// Package printer renders values in the output formats supported by
+// splicectl (json, yaml, gron, text/table, wide, jsonpath, go-template and
+// custom-columns).  Any value can be printed as json, yaml or gron, values
+// that implement Tabular can also be printed as a table.  Adding an output
+// format only requires a new entry in formats.
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/maahsome/gron"
+	"gopkg.in/yaml.v2"
+)
+
+// Options - settings that control how a value is rendered
+type Options struct {
+	// NoHeaders - omit the table header row
+	NoHeaders bool
+	// Wide - include the wide columns of the table
+	Wide bool
+}
+
+// formatFunc - arg is the text following '=' in the format, ie: the
+// template of go-template=<template>
+type formatFunc func(w io.Writer, v interface{}, arg string, opts Options) error
+
+var formats = map[string]formatFunc{
+	"json":           printJSON,
+	"yaml":           printYAML,
+	"gron":           printGRON,
+	"text":           printTable,
+	"table":          printTable,
+	"wide":           printWideTable,
+	"jsonpath":       printJSONPath,
+	"go-template":    printGoTemplate,
+	"custom-columns": printCustomColumns,
+}
+
+// formatArgs - the formats that require an argument
+var formatArgs = map[string]bool{
+	"jsonpath":       true,
+	"go-template":    true,
+	"custom-columns": true,
+}
+
+// Formats - the list of supported output format names
+func Formats() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SplitFormat - split a format such as jsonpath={.clusters[*].dcosAppId} in
+// to the lower cased format name and its argument.
+func SplitFormat(format string) (string, string) {
+	parts := strings.SplitN(format, "=", 2)
+	name := strings.ToLower(parts[0])
+	if len(parts) == 1 {
+		return name, ""
+	}
+	return name, parts[1]
+}
+
+// Validate - check that format names a supported output format and that its
+// argument, if any, can be parsed.
+func Validate(format string) error {
+	name, arg := SplitFormat(format)
+	if _, ok := formats[name]; !ok {
+		return fmt.Errorf("unknown output format %q, expected one of: %s", name, strings.Join(Formats(), "|"))
+	}
+	if formatArgs[name] && len(arg) == 0 {
+		return fmt.Errorf("the %s output format requires an argument, ie: -o %s=<value>", name, name)
+	}
+	switch name {
+	case "jsonpath":
+		_, err := parseJSONPath(arg)
+		return err
+	case "go-template":
+		_, err := parseGoTemplate(arg)
+		return err
+	case "custom-columns":
+		_, err := parseCustomColumns(arg)
+		return err
+	}
+	return nil
+}
+
+// Print - write v to w in the given format
+func Print(w io.Writer, format string, v interface{}, opts Options) error {
+	if err := Validate(format); err != nil {
+		return err
+	}
+	name, arg := SplitFormat(format)
+	return formats[name](w, v, arg, opts)
+}
+
+func printJSON(w io.Writer, v interface{}, arg string, opts Options) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+func printYAML(w io.Writer, v interface{}, arg string, opts Options) error {
+	out, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, string(out))
+	return err
+}
+
+func printGRON(w io.Writer, v interface{}, arg string, opts Options) error {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	ges := gron.NewGron(bytes.NewReader(out), w)
+	ges.SetMonochrome(false)
+	return ges.ToGron()
+}
+
+// printTable - values that don't describe a table, such as the free form CR
+// documents, fall back to YAML which reads well in a terminal.
+func printTable(w io.Writer, v interface{}, arg string, opts Options) error {
+	tv, ok := v.(Tabular)
+	if !ok {
+		return printYAML(w, v, arg, opts)
+	}
+	return WriteTable(w, tv.Table(), opts)
+}
+
+// printWideTable - the table output with the wide columns
+func printWideTable(w io.Writer, v interface{}, arg string, opts Options) error {
+	opts.Wide = true
+	return printTable(w, v, arg, opts)
+}
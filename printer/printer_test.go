@@ -0,0 +1,189 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type testObject struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+func (o *testObject) Table() Table {
+	table := NewTable("NAME", "STATUS")
+	table.AddRow(o.Name, o.Status)
+	return table
+}
+
+func TestPrintJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "json", &testObject{Name: "splicedb", Status: "Active"}, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	expected := "{\n  \"name\": \"splicedb\",\n  \"status\": \"Active\"\n}\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got: %q", expected, buf.String())
+	}
+}
+
+func TestPrintYAML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "YAML", map[string]string{"name": "splicedb"}, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if buf.String() != "name: splicedb\n" {
+		t.Fatalf("unexpected yaml output: %q", buf.String())
+	}
+}
+
+func TestPrintGRON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "gron", &testObject{Name: "splicedb"}, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.Contains(buf.String(), "splicedb") {
+		t.Fatalf("expected the value in the gron output, got: %q", buf.String())
+	}
+}
+
+func TestPrintTable(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "table", &testObject{Name: "splicedb", Status: "Active"}, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected a header and one row, got: %q", buf.String())
+	}
+	if strings.Fields(lines[0])[0] != "NAME" || strings.Fields(lines[1])[1] != "Active" {
+		t.Fatalf("unexpected table output: %q", buf.String())
+	}
+}
+
+func TestPrintTableNoHeaders(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "text", &testObject{Name: "splicedb", Status: "Active"}, Options{NoHeaders: true}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if strings.Contains(buf.String(), "NAME") {
+		t.Fatalf("expected no header row, got: %q", buf.String())
+	}
+}
+
+func TestPrintTableFallback(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "table", map[string]string{"name": "splicedb"}, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if buf.String() != "name: splicedb\n" {
+		t.Fatalf("expected values without a table to fall back to yaml, got: %q", buf.String())
+	}
+}
+
+func TestPrintUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "xml", &testObject{}, Options{}); err == nil {
+		t.Fatal("expected an error for an unknown format")
+	}
+}
+
+type testList struct {
+	Objects []testObject `json:"objects"`
+}
+
+func (l *testList) Items() interface{} {
+	return l.Objects
+}
+
+var list = &testList{Objects: []testObject{
+	{Name: "splicedb", Status: "Active"},
+	{Name: "testdb", Status: "Paused"},
+}}
+
+func TestPrintJSONPath(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "jsonpath={.objects[*].name}", list, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if buf.String() != "splicedb testdb" {
+		t.Fatalf("unexpected jsonpath output: %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := Print(&buf, "jsonpath=.objects[1].status", list, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if buf.String() != "Paused" {
+		t.Fatalf("expected the relaxed jsonpath syntax to be accepted, got: %q", buf.String())
+	}
+}
+
+func TestPrintGoTemplate(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "go-template={{range .objects}}{{.name}}={{.status}};{{end}}", list, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if buf.String() != "splicedb=Active;testdb=Paused;" {
+		t.Fatalf("unexpected go-template output: %q", buf.String())
+	}
+}
+
+func TestPrintCustomColumns(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Print(&buf, "custom-columns=NAME:.name,STATUS:.status,MISSING:.owner", list, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and a row per item, got: %q", buf.String())
+	}
+	if fields := strings.Fields(lines[2]); fields[0] != "testdb" || fields[1] != "Paused" || fields[2] != "<none>" {
+		t.Fatalf("unexpected custom-columns row: %q", lines[2])
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, format := range []string{"json", "TABLE", "jsonpath={.name}", "custom-columns=NAME:.name"} {
+		if err := Validate(format); err != nil {
+			t.Errorf("expected %s to be valid, got: %v", format, err)
+		}
+	}
+	for _, format := range []string{"xml", "jsonpath", "jsonpath={.name", "go-template={{.name", "custom-columns=NAME"} {
+		if err := Validate(format); err == nil {
+			t.Errorf("expected %s to be rejected", format)
+		}
+	}
+}
+
+type wideObject struct {
+	Name  string
+	Owner string
+}
+
+func (o *wideObject) Table() Table {
+	table := NewTable("NAME")
+	table.AddWideColumns("OWNER")
+	table.AddRow(o.Name, o.Owner)
+	return table
+}
+
+func TestPrintWide(t *testing.T) {
+	obj := &wideObject{Name: "splicedb", Owner: "dba@example.com"}
+	var buf bytes.Buffer
+	if err := Print(&buf, "table", obj, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if strings.Contains(buf.String(), "OWNER") || strings.Contains(buf.String(), "dba@example.com") {
+		t.Fatalf("expected no wide columns in the table output, got: %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := Print(&buf, "wide", obj, Options{}); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if !strings.Contains(buf.String(), "OWNER") || !strings.Contains(buf.String(), "dba@example.com") {
+		t.Fatalf("expected the wide columns in the wide output, got: %q", buf.String())
+	}
+}
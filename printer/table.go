@@ -0,0 +1,118 @@
+package printer
+
+import (
+	"io"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// Column - a column of the table output, Wide columns are only shown by the
+// wide output format
+type Column struct {
+	Header string
+	Wide   bool
+}
+
+// Table - the tabular representation of a value, each row holds one cell
+// per column.
+type Table struct {
+	Columns []Column
+	Rows    [][]string
+	// Footer - optional, one cell per column
+	Footer []string
+}
+
+// Tabular - implemented by values that can be rendered as a table
+type Tabular interface {
+	Table() Table
+}
+
+// AddRow - append a row of cells to the table
+func (t *Table) AddRow(cells ...string) {
+	t.Rows = append(t.Rows, cells)
+}
+
+// AddWideColumns - append columns only shown by the wide output format, the
+// rows hold a cell for them like any other column
+func (t *Table) AddWideColumns(headers ...string) {
+	for _, header := range headers {
+		t.Columns = append(t.Columns, Column{Header: header, Wide: true})
+	}
+}
+
+// NewTable - return a table with the given column headers
+func NewTable(headers ...string) Table {
+	columns := make([]Column, len(headers))
+	for i, header := range headers {
+		columns[i] = Column{Header: header}
+	}
+	return Table{Columns: columns}
+}
+
+// WriteTable - render the table to w using the splicectl table style, tab
+// padded columns without borders.
+func WriteTable(w io.Writer, t Table, opts Options) error {
+	t = visibleColumns(t, opts.Wide)
+	table := tablewriter.NewWriter(w)
+	if !opts.NoHeaders {
+		headers := make([]string, len(t.Columns))
+		for i, column := range t.Columns {
+			headers[i] = column.Header
+		}
+		table.SetHeader(headers)
+		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	}
+	table.SetAutoWrapText(false)
+	table.SetAutoFormatHeaders(true)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetHeaderLine(false)
+	table.SetBorder(false)
+	table.SetTablePadding("\t") // pad with tabs
+	table.SetNoWhiteSpace(true)
+	if len(t.Footer) > 0 {
+		table.SetFooter(t.Footer)
+	}
+	table.AppendBulk(t.Rows)
+	table.Render()
+
+	return nil
+}
+
+// visibleColumns - the table without its wide columns unless wide is set
+func visibleColumns(t Table, wide bool) Table {
+	var visible []int
+	for i, column := range t.Columns {
+		if wide || !column.Wide {
+			visible = append(visible, i)
+		}
+	}
+	if len(visible) == len(t.Columns) {
+		return t
+	}
+
+	filtered := Table{}
+	for _, i := range visible {
+		filtered.Columns = append(filtered.Columns, t.Columns[i])
+		if len(t.Footer) > 0 {
+			filtered.Footer = append(filtered.Footer, cell(t.Footer, i))
+		}
+	}
+	for _, row := range t.Rows {
+		cells := make([]string, 0, len(visible))
+		for _, i := range visible {
+			cells = append(cells, cell(row, i))
+		}
+		filtered.Rows = append(filtered.Rows, cells)
+	}
+	return filtered
+}
+
+func cell(cells []string, i int) string {
+	if i < len(cells) {
+		return cells[i]
+	}
+	return ""
+}
@@ -0,0 +1,165 @@
+package printer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Lister - implemented by values that hold a list of items, custom-columns
+// prints one row per item rather than one row for the whole value.
+type Lister interface {
+	Items() interface{}
+}
+
+// genericData - convert v to the maps and slices produced by decoding its
+// JSON, so templates address fields by their JSON names.
+func genericData(v interface{}) (interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// relaxedJSONPath - accept .clusters[*].dcosAppId as well as the full
+// {.clusters[*].dcosAppId} syntax, the same as kubectl.
+func relaxedJSONPath(expr string) string {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "{") {
+		return expr
+	}
+	if !strings.HasPrefix(expr, ".") {
+		expr = "." + expr
+	}
+	return fmt.Sprintf("{%s}", expr)
+}
+
+func parseJSONPath(expr string) (*jsonpath.JSONPath, error) {
+	jp := jsonpath.New("output").AllowMissingKeys(true)
+	if err := jp.Parse(relaxedJSONPath(expr)); err != nil {
+		return nil, fmt.Errorf("error parsing jsonpath %s: %v", expr, err)
+	}
+	return jp, nil
+}
+
+func printJSONPath(w io.Writer, v interface{}, arg string, opts Options) error {
+	jp, err := parseJSONPath(arg)
+	if err != nil {
+		return err
+	}
+	data, err := genericData(v)
+	if err != nil {
+		return err
+	}
+	return jp.Execute(w, data)
+}
+
+func parseGoTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing go-template %s: %v", text, err)
+	}
+	return tmpl, nil
+}
+
+func printGoTemplate(w io.Writer, v interface{}, arg string, opts Options) error {
+	tmpl, err := parseGoTemplate(arg)
+	if err != nil {
+		return err
+	}
+	data, err := genericData(v)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, data)
+}
+
+type customColumn struct {
+	header string
+	path   *jsonpath.JSONPath
+}
+
+// parseCustomColumns - parse NAME:.dcosAppId,STATUS:.status in to columns
+func parseCustomColumns(spec string) ([]customColumn, error) {
+	var columns []customColumn
+	for _, part := range strings.Split(spec, ",") {
+		fields := strings.SplitN(part, ":", 2)
+		if len(fields) != 2 || len(fields[0]) == 0 || len(fields[1]) == 0 {
+			return nil, fmt.Errorf("custom-columns format expects HEADER:JSONPATH pairs, got %q", part)
+		}
+		jp, err := parseJSONPath(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, customColumn{header: fields[0], path: jp})
+	}
+	return columns, nil
+}
+
+func printCustomColumns(w io.Writer, v interface{}, arg string, opts Options) error {
+	columns, err := parseCustomColumns(arg)
+	if err != nil {
+		return err
+	}
+
+	var items []interface{}
+	if lv, ok := v.(Lister); ok {
+		data, err := genericData(lv.Items())
+		if err != nil {
+			return err
+		}
+		items, _ = data.([]interface{})
+	} else {
+		data, err := genericData(v)
+		if err != nil {
+			return err
+		}
+		items = []interface{}{data}
+	}
+
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.header
+	}
+	table := NewTable(headers...)
+	for _, item := range items {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			cell, err := columnValue(column.path, item)
+			if err != nil {
+				return err
+			}
+			row[i] = cell
+		}
+		table.AddRow(row...)
+	}
+	return WriteTable(w, table, opts)
+}
+
+// columnValue - multiple results are joined with a comma, missing values are
+// shown as <none>.
+func columnValue(jp *jsonpath.JSONPath, item interface{}) (string, error) {
+	results, err := jp.FindResults(item)
+	if err != nil {
+		return "", err
+	}
+	var values []string
+	for _, result := range results {
+		for _, value := range result {
+			values = append(values, fmt.Sprintf("%v", value.Interface()))
+		}
+	}
+	if len(values) == 0 {
+		return "<none>", nil
+	}
+	return strings.Join(values, ","), nil
+}